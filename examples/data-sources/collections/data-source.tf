@@ -0,0 +1,16 @@
+# List user-defined collections backed by a table, in navigation order.
+data "directus_collections" "content" {
+  exclude_system  = true
+  exclude_folders = true
+  sort            = ["meta.sort", "collection"]
+}
+
+# Collections inside the "blog" folder, with the filter applied to the
+# collection objects as Directus returns them.
+data "directus_collections" "blog" {
+  filter = jsonencode({ meta = { group = { _eq = "blog" } } })
+}
+
+output "content_collections" {
+  value = [for c in data.directus_collections.content.collections : c.collection]
+}
@@ -0,0 +1,17 @@
+# List the fields of the articles collection.
+data "directus_fields" "articles" {
+  collection = "articles"
+}
+
+# Fields backed by a database column, e.g. to grant permissions on each of them.
+output "article_columns" {
+  value = [for f in data.directus_fields.articles.fields : f.field if f.type != "alias"]
+}
+
+# Many-to-one relations and the tables they point to.
+output "article_relations" {
+  value = {
+    for f in data.directus_fields.articles.fields : f.field => f.foreign_key_table
+    if f.foreign_key_table != null
+  }
+}
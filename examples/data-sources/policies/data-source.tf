@@ -0,0 +1,14 @@
+# List the policies that grant Data Studio access but not admin access.
+data "directus_policies" "app_users" {
+  filter = jsonencode({
+    _and = [
+      { app_access = { _eq = true } },
+      { admin_access = { _eq = false } },
+    ]
+  })
+  sort = ["name"]
+}
+
+output "app_policy_names" {
+  value = [for policy in data.directus_policies.app_users.policies : policy.name]
+}
@@ -0,0 +1,22 @@
+# Look up the built-in public policy, which applies to unauthenticated requests.
+data "directus_policy" "public" {
+  public = true
+}
+
+# Look up a policy by its exact name.
+data "directus_policy" "administrator" {
+  name = "Administrator"
+}
+
+resource "directus_role" "auditors" {
+  name = "Auditors"
+}
+
+resource "directus_access" "auditors_admin" {
+  role_id   = directus_role.auditors.id
+  policy_id = data.directus_policy.administrator.id
+}
+
+output "public_policy_id" {
+  value = data.directus_policy.public.id
+}
@@ -0,0 +1,23 @@
+# Look up the Administrator role created by the Directus installer.
+data "directus_role" "administrator" {
+  name = "Administrator"
+}
+
+# Look up a role by UUID.
+data "directus_role" "editor" {
+  id = var.editor_role_id
+}
+
+resource "directus_role" "senior_editor" {
+  name   = "Senior Editor"
+  parent = data.directus_role.editor.id
+}
+
+variable "editor_role_id" {
+  description = "UUID of an existing editor role."
+  type        = string
+}
+
+output "administrator_users" {
+  value = data.directus_role.administrator.users
+}
@@ -0,0 +1,9 @@
+# List the roles whose name starts with "App", sorted by name.
+data "directus_roles" "app" {
+  filter = jsonencode({ name = { _starts_with = "App" } })
+  sort   = ["name"]
+}
+
+output "app_role_ids" {
+  value = [for role in data.directus_roles.app.roles : role.id]
+}
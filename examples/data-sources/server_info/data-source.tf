@@ -0,0 +1,21 @@
+data "directus_server_info" "this" {}
+
+locals {
+  directus_major_version = tonumber(split(".", data.directus_server_info.this.version)[0])
+}
+
+# Only create resources that need Directus 11 or later on matching instances.
+resource "directus_policy" "content_editors" {
+  count = local.directus_major_version >= 11 ? 1 : 0
+
+  name       = "Content Editors"
+  app_access = true
+}
+
+output "project_name" {
+  value = data.directus_server_info.this.project.name
+}
+
+output "rate_limit" {
+  value = data.directus_server_info.this.rate_limit
+}
@@ -0,0 +1,15 @@
+# Look up a user created by SSO login by email address.
+data "directus_user" "jane" {
+  email = "jane@example.com"
+}
+
+resource "directus_policy" "reviewers" {
+  name       = "Reviewers"
+  app_access = true
+}
+
+# Attach a policy to the user directly.
+resource "directus_access" "jane_reviewers" {
+  policy_id = directus_policy.reviewers.id
+  user_id   = data.directus_user.jane.id
+}
@@ -0,0 +1,23 @@
+# All active users that signed in with the company identity provider.
+data "directus_users" "sso" {
+  filter = jsonencode({ provider = { _eq = "keycloak" } })
+  status = "active"
+  sort   = ["email"]
+}
+
+# A single user by the identifier of the external identity provider.
+data "directus_users" "by_subject" {
+  external_identifier = "5f0c1a9e-2b7d-4c1e-9a34-0e6f1b2c3d4e"
+}
+
+resource "directus_policy" "reviewers" {
+  name       = "Reviewers"
+  app_access = true
+}
+
+resource "directus_access" "sso_reviewers" {
+  for_each = { for user in data.directus_users.sso.users : user.email => user.id }
+
+  policy_id = directus_policy.reviewers.id
+  user_id   = each.value
+}
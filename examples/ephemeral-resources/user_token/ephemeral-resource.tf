@@ -0,0 +1,27 @@
+# Generate a static token for a service user and hand it to a secrets manager
+# through a write-only argument, so the token never reaches Terraform state.
+ephemeral "directus_user_token" "frontend" {
+  user_id = var.frontend_user_id
+  length  = 48
+}
+
+resource "aws_secretsmanager_secret" "frontend_directus_token" {
+  name = "frontend/directus-token"
+}
+
+resource "aws_secretsmanager_secret_version" "frontend_directus_token" {
+  secret_id                = aws_secretsmanager_secret.frontend_directus_token.id
+  secret_string_wo         = ephemeral.directus_user_token.frontend.token
+  secret_string_wo_version = var.frontend_token_version
+}
+
+variable "frontend_user_id" {
+  description = "UUID of the Directus user the frontend authenticates as."
+  type        = string
+}
+
+variable "frontend_token_version" {
+  description = "Increment to rotate the frontend token."
+  type        = number
+  default     = 1
+}
@@ -0,0 +1,5 @@
+# Import by access record UUID
+terraform import directus_access.example 12345678-1234-1234-1234-123456789abc
+
+# Import by role UUID and policy UUID
+terraform import directus_access.example 11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
@@ -0,0 +1,17 @@
+variable "editor_role_id" {
+  description = "UUID of the shared Editor role"
+  type        = string
+}
+
+resource "directus_policy" "reporting" {
+  name        = "Reporting"
+  description = "Read access to reporting collections"
+  app_access  = true
+}
+
+# Attach a single policy to a role managed elsewhere, leaving its
+# other policy attachments untouched.
+resource "directus_access" "editor_reporting" {
+  role_id   = var.editor_role_id
+  policy_id = directus_policy.reporting.id
+}
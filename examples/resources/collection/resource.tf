@@ -3,3 +3,36 @@ resource "directus_collection" "articles" {
   icon       = "article"
   note       = "Blog articles"
 }
+
+resource "directus_collection" "products" {
+  collection       = "products"
+  icon             = "inventory_2"
+  display_template = "{{name}} ({{sku}})"
+
+  archive_field   = "status"
+  archive_value   = "archived"
+  unarchive_value = "published"
+
+  accountability          = "activity"
+  item_duplication_fields = ["name", "description"]
+
+  translations = jsonencode([
+    { language = "de-DE", translation = "Produkte", singular = "Produkt", plural = "Produkte" },
+  ])
+}
+
+resource "directus_collection" "content" {
+  collection = "content"
+  kind       = "folder"
+  icon       = "folder"
+}
+
+resource "directus_collection" "pages" {
+  collection = "pages"
+  group      = directus_collection.content.collection
+
+  primary_key = {
+    name = "slug"
+    type = "string"
+  }
+}
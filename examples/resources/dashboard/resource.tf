@@ -0,0 +1,6 @@
+resource "directus_dashboard" "operations" {
+  name  = "Operations"
+  icon  = "monitoring"
+  note  = "Order and fulfilment metrics"
+  color = "#2ECDA7"
+}
@@ -0,0 +1,17 @@
+# Keep a locally installed extension enabled
+resource "directus_extension" "seo" {
+  name = "directus-extension-seo"
+}
+
+# Disable a single entry of a bundle
+resource "directus_extension" "seo_legacy_panel" {
+  bundle  = directus_extension.seo.name
+  name    = "seo-legacy-panel"
+  enabled = false
+}
+
+# Install an extension from the registry configured on the server
+resource "directus_extension" "audit_hook" {
+  registry_extension_id = "3f1c2a8e-5b7d-4e61-9a0c-2d4f6b8e1a37"
+  registry_version_id   = "9b2e4d6f-1a3c-4e5b-8d7f-0c2a4e6b8d19"
+}
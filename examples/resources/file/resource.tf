@@ -0,0 +1,22 @@
+resource "directus_folder" "brand" {
+  name = "brand"
+}
+
+# Upload a local file. Editing logo.svg replaces the file content in place.
+resource "directus_file" "logo" {
+  source      = "${path.module}/assets/logo.svg"
+  title       = "Company Logo"
+  description = "Primary logo used in the app header"
+  folder      = directus_folder.brand.id
+  tags        = ["brand", "logo"]
+
+  metadata = jsonencode({
+    owner = "design-team"
+  })
+}
+
+# Import a file from a remote URL.
+resource "directus_file" "terms" {
+  url   = "https://example.com/templates/terms.pdf"
+  title = "Terms Template"
+}
@@ -0,0 +1,23 @@
+resource "directus_flow" "notify_on_publish" {
+  name        = "Notify on publish"
+  icon        = "campaign"
+  color       = "#6644FF"
+  description = "Logs every newly created article."
+  status      = "active"
+  trigger     = "event"
+
+  options = jsonencode({
+    type        = "action"
+    scope       = ["items.create"]
+    collections = ["articles"]
+  })
+}
+
+resource "directus_flow" "nightly_cleanup" {
+  name    = "Nightly cleanup"
+  trigger = "schedule"
+
+  options = jsonencode({
+    cron = "0 3 * * *"
+  })
+}
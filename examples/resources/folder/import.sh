@@ -0,0 +1 @@
+terraform import directus_folder.example 12345678-1234-1234-1234-123456789abc
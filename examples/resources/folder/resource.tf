@@ -0,0 +1,16 @@
+resource "directus_folder" "brand" {
+  name = "brand"
+}
+
+resource "directus_folder" "logos" {
+  name   = "logos"
+  parent = directus_folder.brand.id
+}
+
+resource "directus_folder" "products" {
+  name = "products"
+}
+
+resource "directus_folder" "legal" {
+  name = "legal"
+}
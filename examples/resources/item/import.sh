@@ -0,0 +1,5 @@
+# Import by collection name and primary key
+terraform import directus_item.country_de countries/DE
+
+# Import a singleton by collection name
+terraform import directus_item.site_settings site_settings
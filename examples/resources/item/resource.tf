@@ -0,0 +1,32 @@
+# Reference data with an auto-generated primary key
+resource "directus_item" "category_news" {
+  collection = "categories"
+
+  data = jsonencode({
+    name = "News"
+    slug = "news"
+  })
+}
+
+# Reference data with a fixed, human-readable primary key
+resource "directus_item" "country_de" {
+  collection        = "countries"
+  primary_key_field = "code"
+  primary_key       = "DE"
+
+  data = jsonencode({
+    name     = "Germany"
+    currency = "EUR"
+  })
+}
+
+# Default values of a singleton collection
+resource "directus_item" "site_settings" {
+  collection = "site_settings"
+  singleton  = true
+
+  data = jsonencode({
+    title            = "My Site"
+    maintenance_mode = false
+  })
+}
@@ -0,0 +1,22 @@
+# Seed a lookup table inline. Categories referencing a parent category
+# are created after their parent.
+resource "directus_items" "categories" {
+  collection = "categories"
+
+  records = jsonencode([
+    { id = 1, name = "News", parent = null },
+    { id = 2, name = "Local News", parent = 1 },
+    { id = 3, name = "Sports", parent = null },
+  ])
+}
+
+# Seed a lookup table from a CSV file with a header row, e.g.:
+#
+#   code,name,currency
+#   DE,Germany,EUR
+#   CH,Switzerland,CHF
+resource "directus_items" "countries" {
+  collection        = "countries"
+  primary_key_field = "code"
+  source            = "${path.module}/countries.csv"
+}
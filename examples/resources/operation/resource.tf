@@ -0,0 +1,55 @@
+resource "directus_flow" "notify_on_publish" {
+  name    = "Notify on publish"
+  trigger = "event"
+
+  options = jsonencode({
+    type        = "action"
+    scope       = ["items.create"]
+    collections = ["articles"]
+  })
+}
+
+# Operations referenced by resolve/reject are declared first so that
+# Terraform creates them before the operation pointing to them.
+resource "directus_operation" "log_success" {
+  flow       = directus_flow.notify_on_publish.id
+  name       = "Log success"
+  key        = "log_success"
+  type       = "log"
+  position_x = 37
+  position_y = 1
+
+  options = jsonencode({
+    message = "Article {{$trigger.key}} created"
+  })
+}
+
+resource "directus_operation" "log_failure" {
+  flow       = directus_flow.notify_on_publish.id
+  name       = "Log failure"
+  key        = "log_failure"
+  type       = "log"
+  position_x = 37
+  position_y = 17
+
+  options = jsonencode({
+    message = "Could not read article {{$trigger.key}}"
+  })
+}
+
+resource "directus_operation" "read_article" {
+  flow       = directus_flow.notify_on_publish.id
+  name       = "Read article"
+  key        = "read_article"
+  type       = "item-read"
+  position_x = 19
+  position_y = 1
+  entrypoint = true
+  resolve    = directus_operation.log_success.id
+  reject     = directus_operation.log_failure.id
+
+  options = jsonencode({
+    collection = "articles"
+    key        = ["{{$trigger.key}}"]
+  })
+}
@@ -0,0 +1,42 @@
+resource "directus_dashboard" "operations" {
+  name = "Operations"
+}
+
+resource "directus_panel" "open_orders" {
+  dashboard   = directus_dashboard.operations.id
+  type        = "metric"
+  name        = "Open orders"
+  icon        = "shopping_cart"
+  show_header = true
+  position_x  = 1
+  position_y  = 1
+  width       = 12
+  height      = 6
+
+  options = jsonencode({
+    collection = "orders"
+    field      = "id"
+    function   = "count"
+    filter     = { status = { _eq = "open" } }
+  })
+}
+
+resource "directus_panel" "orders_over_time" {
+  dashboard   = directus_dashboard.operations.id
+  type        = "time-series"
+  name        = "Orders per day"
+  show_header = true
+  position_x  = 13
+  position_y  = 1
+  width       = 24
+  height      = 12
+
+  options = jsonencode({
+    collection = "orders"
+    dateField  = "date_created"
+    valueField = "id"
+    function   = "count"
+    precision  = "day"
+    range      = "30 days"
+  })
+}
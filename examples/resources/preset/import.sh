@@ -0,0 +1 @@
+terraform import directus_preset.example 42
@@ -0,0 +1,37 @@
+# Default table layout of the articles collection for editors
+resource "directus_preset" "articles_editor_default" {
+  collection = "articles"
+  role       = directus_role.editor.id
+  layout     = "tabular"
+
+  layout_query = jsonencode({
+    tabular = {
+      fields = ["title", "status", "author", "date_published"]
+      sort   = ["-date_published"]
+      limit  = 50
+    }
+  })
+
+  layout_options = jsonencode({
+    tabular = {
+      widths = { title = 320 }
+    }
+  })
+}
+
+# Bookmark shared with every user
+resource "directus_preset" "articles_drafts" {
+  collection = "articles"
+  bookmark   = "Drafts"
+  icon       = "edit_note"
+  color      = "#FFA439"
+  layout     = "tabular"
+
+  filter = jsonencode({
+    status = { _eq = "draft" }
+  })
+}
+
+resource "directus_role" "editor" {
+  name = "Editor"
+}
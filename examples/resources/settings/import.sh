@@ -0,0 +1 @@
+terraform import directus_settings.this settings
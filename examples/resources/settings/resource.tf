@@ -0,0 +1,36 @@
+resource "directus_settings" "this" {
+  project_name       = "Acme Content"
+  project_descriptor = "Editorial platform"
+  project_url        = "https://acme.example.com"
+  project_color      = "#6644FF"
+  default_language   = "en-US"
+
+  public_registration = false
+
+  auth_password_policy = "/^.{12,}$/"
+  auth_login_attempts  = 10
+
+  storage_default_folder  = directus_folder.uploads.id
+  storage_asset_transform = "presets"
+  storage_asset_presets = jsonencode([
+    {
+      key     = "thumbnail"
+      fit     = "cover"
+      width   = 200
+      height  = 200
+      quality = 80
+    }
+  ])
+
+  module_bar = jsonencode([
+    { type = "module", id = "content", enabled = true },
+    { type = "module", id = "users", enabled = true },
+    { type = "module", id = "files", enabled = true },
+    { type = "module", id = "insights", enabled = true },
+    { type = "module", id = "settings", enabled = true, locked = true },
+  ])
+}
+
+resource "directus_folder" "uploads" {
+  name = "uploads"
+}
@@ -0,0 +1,25 @@
+# Read-only preview link for a single article, limited to the press embargo window
+resource "directus_share" "press_preview" {
+  name       = "Press preview"
+  collection = "articles"
+  item       = directus_item.launch_article.primary_key
+  role       = directus_role.press.id
+
+  date_start = "2025-03-01T08:00:00Z"
+  date_end   = "2025-03-08T08:00:00Z"
+  max_uses   = 50
+
+  # Write-only (Terraform >= 1.11): never stored in state.
+  # Bump the version to send a new password.
+  password_wo         = var.press_preview_password
+  password_wo_version = 1
+}
+
+variable "press_preview_password" {
+  type      = string
+  sensitive = true
+}
+
+output "press_preview_url" {
+  value = directus_share.press_preview.url
+}
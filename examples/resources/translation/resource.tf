@@ -0,0 +1,17 @@
+resource "directus_translation" "articles_en" {
+  key      = "articles"
+  language = "en-US"
+  value    = "Articles"
+}
+
+resource "directus_translation" "articles_de" {
+  key      = "articles"
+  language = "de-DE"
+  value    = "Artikel"
+}
+
+# Referenced in the Data Studio as "$t:articles"
+resource "directus_collection" "articles" {
+  collection = "articles"
+  note       = "$t:articles"
+}
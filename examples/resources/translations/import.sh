@@ -0,0 +1 @@
+terraform import directus_translations.studio en-US,de-DE
@@ -0,0 +1,17 @@
+# Authoritative: any other en-US or de-DE translation is deleted.
+resource "directus_translations" "studio" {
+  translations = {
+    "en-US" = {
+      articles         = "Articles"
+      article_title    = "Title"
+      article_body     = "Body"
+      publish_date_tip = "Leave empty to publish immediately"
+    }
+    "de-DE" = {
+      articles         = "Artikel"
+      article_title    = "Titel"
+      article_body     = "Inhalt"
+      publish_date_tip = "Leer lassen, um sofort zu veröffentlichen"
+    }
+  }
+}
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"sort"
 	"time"
 )
 
@@ -81,13 +83,18 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
+	return c.doRawRequest(ctx, method, path, "application/json", reqBody)
+}
+
+// doRawRequest performs an authenticated HTTP request with a pre-encoded body and content type.
+func (c *Client) doRawRequest(ctx context.Context, method, path, contentType string, body io.Reader) (*http.Response, error) {
 	fullURL := c.BaseURL + path
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 
 	resp, err := c.HTTPClient.Do(req)
@@ -182,26 +189,43 @@ func (c *Client) GetWithParams(ctx context.Context, collection, id string, param
 	return nil
 }
 
+// singletonCollections are system collections holding exactly one record,
+// which is addressed without an ID (e.g. GET /settings).
+var singletonCollections = map[string]bool{
+	"settings": true,
+}
+
 // buildCollectionPath builds the correct API path for a collection
 // System collections (roles, policies, users, etc.) use /{collection} format
 // Custom collections use /items/{collection} format
+// Singleton system collections (settings) always use /{collection}, ignoring the id
 func (c *Client) buildCollectionPath(collection string, id string) string {
+	if singletonCollections[collection] {
+		return fmt.Sprintf("/%s", collection)
+	}
+
 	systemCollections := map[string]bool{
-		"collections": true,
-		"roles":       true,
-		"policies":    true,
-		"users":       true,
-		"folders":     true,
-		"files":       true,
-		"activity":    true,
-		"revisions":   true,
-		"webhooks":    true,
-		"flows":       true,
-		"operations":  true,
-		"dashboards":  true,
-		"panels":      true,
-		"shares":      true,
-		"settings":    true,
+		"collections":  true,
+		"roles":        true,
+		"policies":     true,
+		"users":        true,
+		"folders":      true,
+		"files":        true,
+		"activity":     true,
+		"revisions":    true,
+		"webhooks":     true,
+		"flows":        true,
+		"operations":   true,
+		"dashboards":   true,
+		"panels":       true,
+		"shares":       true,
+		"settings":     true,
+		"access":       true,
+		"presets":      true,
+		"translations": true,
+		"relations":    true,
+		"extensions":   true,
+		"fields":       true,
 	}
 
 	if systemCollections[collection] {
@@ -237,6 +261,82 @@ func (c *Client) List(ctx context.Context, collection string, result interface{}
 	return nil
 }
 
+// ListWithParams retrieves multiple items from a collection with query parameters.
+// This is useful for filtering (e.g., ?filter={"role":{"_eq":"..."}}) and limiting results.
+func (c *Client) ListWithParams(ctx context.Context, collection string, params map[string]string, result interface{}) error {
+	if collection == "" {
+		return fmt.Errorf("collection is required")
+	}
+
+	path := c.buildCollectionPath(collection, "")
+	if len(params) > 0 {
+		q := url.Values{}
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		path += "?" + q.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// GetSingleton retrieves the single record of a singleton collection, such as
+// settings or a custom collection flagged as singleton (GET /items/{collection}).
+func (c *Client) GetSingleton(ctx context.Context, collection string, result interface{}) error {
+	if collection == "" {
+		return fmt.Errorf("collection is required")
+	}
+
+	path := c.buildCollectionPath(collection, "")
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSingleton updates the single record of a singleton collection
+// (PATCH /settings or PATCH /items/{collection}).
+func (c *Client) UpdateSingleton(ctx context.Context, collection string, data interface{}, result interface{}) error {
+	if collection == "" {
+		return fmt.Errorf("collection is required")
+	}
+	if data == nil {
+		return fmt.Errorf("data is required")
+	}
+
+	path := c.buildCollectionPath(collection, "")
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // Create creates a new item in a collection
 func (c *Client) Create(ctx context.Context, collection string, data interface{}, result interface{}) error {
 	if collection == "" {
@@ -309,6 +409,227 @@ func (c *Client) Delete(ctx context.Context, collection, id string) error {
 	return nil
 }
 
+// CreateMany creates several items in one request (POST with an array body).
+func (c *Client) CreateMany(ctx context.Context, collection string, items []map[string]interface{}, result interface{}) error {
+	return c.batchRequest(ctx, http.MethodPost, collection, items, result)
+}
+
+// UpdateMany updates several items in one request (PATCH with an array body).
+// Each item must contain its primary key.
+func (c *Client) UpdateMany(ctx context.Context, collection string, items []map[string]interface{}, result interface{}) error {
+	return c.batchRequest(ctx, http.MethodPatch, collection, items, result)
+}
+
+// DeleteMany deletes several items in one request (DELETE with an array of primary keys).
+func (c *Client) DeleteMany(ctx context.Context, collection string, keys []interface{}) error {
+	if collection == "" {
+		return fmt.Errorf("collection is required")
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("keys are required")
+	}
+
+	path := c.buildCollectionPath(collection, "")
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, keys)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// batchRequest sends an array of items to the collection endpoint.
+func (c *Client) batchRequest(ctx context.Context, method, collection string, items []map[string]interface{}, result interface{}) error {
+	if collection == "" {
+		return fmt.Errorf("collection is required")
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("items are required")
+	}
+
+	path := c.buildCollectionPath(collection, "")
+	resp, err := c.doRequest(ctx, method, path, items)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UploadFile uploads file content as multipart/form-data.
+// An empty id creates a new file via POST /files; otherwise the content of
+// the existing file is replaced via PATCH /files/{id}.
+// Directus requires all non-file form fields to precede the file itself.
+func (c *Client) UploadFile(ctx context.Context, id string, fields map[string]string, fileName string, content io.Reader, result interface{}) error {
+	if fileName == "" {
+		return fmt.Errorf("file name is required")
+	}
+	if content == nil {
+		return fmt.Errorf("file content is required")
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := writer.WriteField(k, fields[k]); err != nil {
+			return fmt.Errorf("failed to write form field %s: %w", k, err)
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return fmt.Errorf("failed to read file content: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to finalize multipart body: %w", err)
+	}
+
+	method := http.MethodPost
+	if id != "" {
+		method = http.MethodPatch
+	}
+
+	resp, err := c.doRawRequest(ctx, method, c.buildCollectionPath("files", id), writer.FormDataContentType(), &buf)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ImportFile imports a file from a remote URL via POST /files/import.
+// The optional data map sets file fields such as title, folder or tags.
+func (c *Client) ImportFile(ctx context.Context, fileURL string, data map[string]interface{}, result interface{}) error {
+	if fileURL == "" {
+		return fmt.Errorf("url is required")
+	}
+
+	body := map[string]interface{}{
+		"url": fileURL,
+	}
+	if len(data) > 0 {
+		body["data"] = data
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "/files/import", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// InstallExtension installs a version of an extension from the configured
+// extension registry via POST /extensions/registry/install.
+func (c *Client) InstallExtension(ctx context.Context, extensionID, versionID string) error {
+	if extensionID == "" {
+		return fmt.Errorf("extension is required")
+	}
+	if versionID == "" {
+		return fmt.Errorf("version is required")
+	}
+
+	body := map[string]interface{}{
+		"extension": extensionID,
+		"version":   versionID,
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "/extensions/registry/install", body)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// UninstallExtension removes an extension that was installed from the registry
+// via DELETE /extensions/registry/uninstall/{id}.
+func (c *Client) UninstallExtension(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/extensions/registry/uninstall/"+id, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// RandomString generates a cryptographically secure random string of the given
+// length using GET /utils/random/string.
+func (c *Client) RandomString(ctx context.Context, length int) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("length must be positive")
+	}
+
+	path := fmt.Sprintf("/utils/random/string?length=%d", length)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data string `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// ServerInfo retrieves information about the Directus instance (GET /server/info).
+// The fields included depend on the permissions of the token.
+func (c *Client) ServerInfo(ctx context.Context, result interface{}) error {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/server/info", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
 // Ping checks if the Directus server is reachable
 func (c *Client) Ping(ctx context.Context) error {
 	resp, err := c.doRequest(ctx, http.MethodGet, "/server/ping", nil)
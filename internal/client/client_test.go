@@ -3,8 +3,10 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -105,6 +107,13 @@ func TestBuildCollectionPath(t *testing.T) {
 		{"users with id", "users", "user-1", "/users/user-1"},
 		{"folders with id", "folders", "folder-1", "/folders/folder-1"},
 		{"settings without id", "settings", "", "/settings"},
+		{"settings ignores id", "settings", "1", "/settings"},
+		{"access with id", "access", "access-1", "/access/access-1"},
+		{"presets with id", "presets", "7", "/presets/7"},
+		{"translations without id", "translations", "", "/translations"},
+		{"relations by collection", "relations", "articles", "/relations/articles"},
+		{"extensions with id", "extensions", "ext-1", "/extensions/ext-1"},
+		{"fields by collection", "fields", "articles", "/fields/articles"},
 
 		// Custom collections (items prefix)
 		{"custom collection with id", "articles", "1", "/items/articles/1"},
@@ -288,6 +297,131 @@ func TestList_SystemCollection(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// ---------------------------------------------------------------------------
+// ListWithParams
+// ---------------------------------------------------------------------------
+
+func TestListWithParams_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/access", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, `{"role":{"_eq":"role-1"}}`, r.URL.Query().Get("filter"))
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "access-1", "role": "role-1", "policy": "policy-a"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	params := map[string]string{
+		"filter": `{"role":{"_eq":"role-1"}}`,
+		"limit":  "1",
+	}
+	var result map[string]interface{}
+	err := newTestClient(server).ListWithParams(context.Background(), "access", params, &result)
+
+	require.NoError(t, err)
+	data := result["data"].([]interface{})
+	assert.Len(t, data, 1)
+}
+
+func TestListWithParams_NoParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/items/articles", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{}})
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	err := newTestClient(server).ListWithParams(context.Background(), "articles", nil, &result)
+	require.NoError(t, err)
+}
+
+func TestListWithParams_MissingCollection(t *testing.T) {
+	err := offlineClient().ListWithParams(context.Background(), "", nil, &map[string]interface{}{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collection is required")
+}
+
+// ---------------------------------------------------------------------------
+// GetSingleton / UpdateSingleton
+// ---------------------------------------------------------------------------
+
+func TestGetSingleton_Settings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"id": 1, "project_name": "Directus"},
+		})
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	err := newTestClient(server).GetSingleton(context.Background(), "settings", &result)
+
+	require.NoError(t, err)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, "Directus", data["project_name"])
+}
+
+func TestGetSingleton_CustomCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/items/homepage", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"id": 1}})
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	require.NoError(t, newTestClient(server).GetSingleton(context.Background(), "homepage", &result))
+}
+
+func TestGetSingleton_MissingCollection(t *testing.T) {
+	err := offlineClient().GetSingleton(context.Background(), "", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collection is required")
+}
+
+func TestUpdateSingleton_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		var payload map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "Acme", payload["project_name"])
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"id": 1, "project_name": "Acme"},
+		})
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	err := newTestClient(server).UpdateSingleton(context.Background(), "settings", map[string]interface{}{"project_name": "Acme"}, &result)
+
+	require.NoError(t, err)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, "Acme", data["project_name"])
+}
+
+func TestUpdateSingleton_MissingData(t *testing.T) {
+	err := offlineClient().UpdateSingleton(context.Background(), "settings", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "data is required")
+}
+
 // ---------------------------------------------------------------------------
 // Create
 // ---------------------------------------------------------------------------
@@ -440,6 +574,304 @@ func TestDelete_SystemCollection(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// ---------------------------------------------------------------------------
+// CreateMany / UpdateMany / DeleteMany
+// ---------------------------------------------------------------------------
+
+func TestCreateMany_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/translations", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var payload []map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		require.Len(t, payload, 2)
+		assert.Equal(t, "greeting", payload[0]["key"])
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{{"id": "t-1"}, {"id": "t-2"}},
+		})
+	}))
+	defer server.Close()
+
+	var result struct {
+		Data []map[string]interface{} `json:"data"`
+	}
+	err := newTestClient(server).CreateMany(context.Background(), "translations", []map[string]interface{}{
+		{"language": "en-US", "key": "greeting", "value": "Hello"},
+		{"language": "de-DE", "key": "greeting", "value": "Hallo"},
+	}, &result)
+
+	require.NoError(t, err)
+	assert.Len(t, result.Data, 2)
+}
+
+func TestUpdateMany_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/items/articles", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		var payload []map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, []map[string]interface{}{{"id": float64(1), "title": "A"}}, payload)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	err := newTestClient(server).UpdateMany(context.Background(), "articles", []map[string]interface{}{{"id": 1, "title": "A"}}, nil)
+	require.NoError(t, err)
+}
+
+func TestBatch_MissingItems(t *testing.T) {
+	err := offlineClient().CreateMany(context.Background(), "articles", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "items are required")
+
+	err = offlineClient().UpdateMany(context.Background(), "", []map[string]interface{}{{"id": 1}}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collection is required")
+}
+
+func TestDeleteMany_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/translations", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		var payload []interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, []interface{}{"t-1", "t-2"}, payload)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := newTestClient(server).DeleteMany(context.Background(), "translations", []interface{}{"t-1", "t-2"})
+	require.NoError(t, err)
+}
+
+func TestDeleteMany_MissingKeys(t *testing.T) {
+	err := offlineClient().DeleteMany(context.Background(), "translations", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "keys are required")
+}
+
+// ---------------------------------------------------------------------------
+// UploadFile / ImportFile
+// ---------------------------------------------------------------------------
+
+func TestUploadFile_Create(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		reader, err := r.MultipartReader()
+		require.NoError(t, err)
+
+		// Form fields must precede the file part.
+		part, err := reader.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, "folder", part.FormName())
+		value, _ := io.ReadAll(part)
+		assert.Equal(t, "folder-1", string(value))
+
+		part, err = reader.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, "file", part.FormName())
+		assert.Equal(t, "logo.svg", part.FileName())
+		content, _ := io.ReadAll(part)
+		assert.Equal(t, "<svg/>", string(content))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"id": "file-1"},
+		})
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	err := newTestClient(server).UploadFile(context.Background(), "", map[string]string{"folder": "folder-1"}, "logo.svg", strings.NewReader("<svg/>"), &result)
+
+	require.NoError(t, err)
+	assert.Equal(t, "file-1", result["data"].(map[string]interface{})["id"])
+}
+
+func TestUploadFile_Replace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files/file-1", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	err := newTestClient(server).UploadFile(context.Background(), "file-1", nil, "logo.svg", strings.NewReader("<svg/>"), nil)
+	require.NoError(t, err)
+}
+
+func TestUploadFile_MissingFileName(t *testing.T) {
+	err := offlineClient().UploadFile(context.Background(), "", nil, "", strings.NewReader("x"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "file name is required")
+}
+
+func TestImportFile_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files/import", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		assert.Equal(t, "https://example.com/logo.png", payload["url"])
+		assert.Equal(t, "Logo", payload["data"].(map[string]interface{})["title"])
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"id": "file-2"},
+		})
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	err := newTestClient(server).ImportFile(context.Background(), "https://example.com/logo.png", map[string]interface{}{"title": "Logo"}, &result)
+	require.NoError(t, err)
+}
+
+func TestImportFile_MissingURL(t *testing.T) {
+	err := offlineClient().ImportFile(context.Background(), "", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "url is required")
+}
+
+// ---------------------------------------------------------------------------
+// InstallExtension / UninstallExtension
+// ---------------------------------------------------------------------------
+
+func TestInstallExtension_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/extensions/registry/install", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "ext-1", body["extension"])
+		assert.Equal(t, "ver-1", body["version"])
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := newTestClient(server).InstallExtension(context.Background(), "ext-1", "ver-1")
+	require.NoError(t, err)
+}
+
+func TestInstallExtension_MissingArguments(t *testing.T) {
+	err := offlineClient().InstallExtension(context.Background(), "", "ver-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "extension is required")
+
+	err = offlineClient().InstallExtension(context.Background(), "ext-1", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "version is required")
+}
+
+func TestInstallExtension_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"errors":[{"message":"Marketplace is disabled","extensions":{"code":"SERVICE_UNAVAILABLE"}}]}`))
+	}))
+	defer server.Close()
+
+	err := newTestClient(server).InstallExtension(context.Background(), "ext-1", "ver-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Marketplace is disabled")
+}
+
+func TestUninstallExtension_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/extensions/registry/uninstall/ext-1", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := newTestClient(server).UninstallExtension(context.Background(), "ext-1")
+	require.NoError(t, err)
+}
+
+func TestUninstallExtension_MissingID(t *testing.T) {
+	err := offlineClient().UninstallExtension(context.Background(), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "id is required")
+}
+
+// ---------------------------------------------------------------------------
+// RandomString
+// ---------------------------------------------------------------------------
+
+func TestRandomString_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/utils/random/string", r.URL.Path)
+		assert.Equal(t, "48", r.URL.Query().Get("length"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":"abc123"}`))
+	}))
+	defer server.Close()
+
+	value, err := newTestClient(server).RandomString(context.Background(), 48)
+
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", value)
+}
+
+func TestRandomString_InvalidLength(t *testing.T) {
+	_, err := offlineClient().RandomString(context.Background(), 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "length must be positive")
+}
+
+// ---------------------------------------------------------------------------
+// ServerInfo
+// ---------------------------------------------------------------------------
+
+func TestServerInfo_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/server/info", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"version": "11.1.0"},
+		})
+	}))
+	defer server.Close()
+
+	var result struct {
+		Data struct {
+			Version string `json:"version"`
+		} `json:"data"`
+	}
+	require.NoError(t, newTestClient(server).ServerInfo(context.Background(), &result))
+	assert.Equal(t, "11.1.0", result.Data.Version)
+}
+
+func TestServerInfo_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":[{"message":"Invalid user credentials.","extensions":{"code":"INVALID_CREDENTIALS"}}]}`))
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	err := newTestClient(server).ServerInfo(context.Background(), &result)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "INVALID_CREDENTIALS")
+}
+
 // ---------------------------------------------------------------------------
 // Ping
 // ---------------------------------------------------------------------------
@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &AccessResource{}
+	_ resource.ResourceWithConfigure      = &AccessResource{}
+	_ resource.ResourceWithImportState    = &AccessResource{}
+	_ resource.ResourceWithValidateConfig = &AccessResource{}
+)
+
+// NewAccessResource creates a new access resource.
+func NewAccessResource() resource.Resource {
+	return &AccessResource{}
+}
+
+// AccessResource manages a single row of the directus_access junction table.
+// Unlike directus_role_policies_attachment, this resource is non-authoritative:
+// other attachments of the same role or user are left untouched.
+type AccessResource struct {
+	client *client.Client
+}
+
+// AccessResourceModel describes the resource data model.
+type AccessResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	RoleID   types.String `tfsdk:"role_id"`
+	UserID   types.String `tfsdk:"user_id"`
+	PolicyID types.String `tfsdk:"policy_id"`
+	Sort     types.Int64  `tfsdk:"sort"`
+}
+
+func (r *AccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access"
+}
+
+func (r *AccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single `directus_access` record linking a policy to a role or a user. " +
+			"This resource is **non-authoritative**: other policy attachments of the same role or user are left untouched, " +
+			"so several modules can attach policies to a shared role.\n\n" +
+			"Import using the access record UUID or `role_id/policy_id`: " +
+			"`terraform import directus_access.example <access_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the access record (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the role the policy is attached to. Conflicts with `user_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the user the policy is attached to. Conflicts with `role_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the policy to attach.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sort": schema.Int64Attribute{
+				MarkdownDescription: "The sort order of this policy within the role or user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the access record targets exactly one of a role or a user.
+func (r *AccessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccessResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are not yet known (e.g. references to resources being created)
+	// cannot be checked until apply.
+	if data.RoleID.IsUnknown() || data.UserID.IsUnknown() {
+		return
+	}
+
+	if !data.RoleID.IsNull() && !data.UserID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Conflicting Access Target",
+			"Only one of role_id or user_id can be set on a directus_access record.",
+		)
+		return
+	}
+
+	if data.RoleID.IsNull() && data.UserID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_id"),
+			"Missing Access Target",
+			"One of role_id or user_id must be set on a directus_access record.",
+		)
+	}
+}
+
+func (r *AccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create inserts a single access record.
+func (r *AccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data accessAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "access", buildAccessInput(plan), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Access Record",
+			fmt.Sprintf("Could not attach policy %s: %s", plan.PolicyID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+// Read refreshes the access record from the Directus API.
+func (r *AccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data accessAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "access", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Access Record",
+			fmt.Sprintf("Could not read access record %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+// Update changes the sort order of the access record. All other attributes force replacement.
+func (r *AccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := make(map[string]interface{})
+	setInt64Field(reqBody, "sort", plan.Sort)
+
+	var response struct {
+		Data accessAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "access", plan.ID.ValueString(), reqBody, &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Access Record",
+			fmt.Sprintf("Could not update access record %s: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+// Delete removes only this access record.
+func (r *AccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "access", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Access Record",
+			fmt.Sprintf("Could not delete access record %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports an access record either by its UUID or by `role_id/policy_id`.
+func (r *AccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.Contains(req.ID, "/") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format <access_id> or <role_id>/<policy_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	roleID, policyID := parts[0], parts[1]
+
+	accessID, err := r.findAccessID(ctx, roleID, policyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Access Record During Import",
+			fmt.Sprintf("Could not find access record for role %s and policy %s: %s", roleID, policyID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessID)...)
+}
+
+// findAccessID looks up the access record linking the given role and policy.
+func (r *AccessResource) findAccessID(ctx context.Context, roleID, policyID string) (string, error) {
+	filter, err := json.Marshal(map[string]interface{}{
+		"role":   map[string]string{"_eq": roleID},
+		"policy": map[string]string{"_eq": policyID},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Data []accessAPIResponse `json:"data"`
+	}
+
+	params := map[string]string{
+		"filter": string(filter),
+		"fields": "id",
+		"limit":  "1",
+	}
+
+	if err := r.client.ListWithParams(ctx, "access", params, &result); err != nil {
+		return "", err
+	}
+
+	if len(result.Data) == 0 {
+		return "", fmt.Errorf("policy is not attached to role")
+	}
+
+	return result.Data[0].ID, nil
+}
+
+// accessAPIResponse represents a row of the directus_access junction table.
+type accessAPIResponse struct {
+	ID     string `json:"id"`
+	Role   string `json:"role,omitempty"`
+	User   string `json:"user,omitempty"`
+	Policy string `json:"policy"`
+	Sort   *int64 `json:"sort,omitempty"`
+}
+
+// toModel converts accessAPIResponse to AccessResourceModel
+func (a *accessAPIResponse) toModel() *AccessResourceModel {
+	return &AccessResourceModel{
+		ID:       types.StringValue(a.ID),
+		RoleID:   stringOrNull(a.Role),
+		UserID:   stringOrNull(a.User),
+		PolicyID: types.StringValue(a.Policy),
+		Sort:     types.Int64PointerValue(a.Sort),
+	}
+}
+
+// buildAccessInput constructs the create payload for an access record.
+func buildAccessInput(data AccessResourceModel) map[string]interface{} {
+	input := map[string]interface{}{
+		"policy": data.PolicyID.ValueString(),
+	}
+
+	setStringField(input, "role", data.RoleID)
+	setStringField(input, "user", data.UserID)
+	setInt64Field(input, "sort", data.Sort)
+
+	return input
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccess_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_access", "access"),
+		Steps: []resource.TestStep{
+			// Two independent access records on the same role
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_policy" "a" {
+  name       = "AccTest Access Policy A"
+  app_access = true
+}
+
+resource "directus_policy" "b" {
+  name = "AccTest Access Policy B"
+}
+
+resource "directus_role" "shared" {
+  name = "AccTest Access Shared Role"
+}
+
+resource "directus_access" "a" {
+  role_id   = directus_role.shared.id
+  policy_id = directus_policy.a.id
+}
+
+resource "directus_access" "b" {
+  role_id   = directus_role.shared.id
+  policy_id = directus_policy.b.id
+  sort      = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_access.a", "id"),
+					resource.TestCheckResourceAttrPair("directus_access.a", "role_id", "directus_role.shared", "id"),
+					resource.TestCheckResourceAttrPair("directus_access.b", "policy_id", "directus_policy.b", "id"),
+					resource.TestCheckResourceAttr("directus_access.b", "sort", "2"),
+				),
+			},
+			// ImportState by access ID
+			{
+				ResourceName:      "directus_access.a",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestAccessResourceSchema(t *testing.T) {
+	r := &AccessResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "role_id", "user_id", "policy_id", "sort"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["policy_id"].IsRequired())
+}
+
+func TestAccessResourceMetadata(t *testing.T) {
+	r := &AccessResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_access", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestAccessResource_ValidateConfig(t *testing.T) {
+	r := &AccessResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		role      types.String
+		user      types.String
+		expectErr bool
+	}{
+		{"role only", types.StringValue("role-1"), types.StringNull(), false},
+		{"user only", types.StringNull(), types.StringValue("user-1"), false},
+		{"both set", types.StringValue("role-1"), types.StringValue("user-1"), true},
+		{"neither set", types.StringNull(), types.StringNull(), true},
+		{"unknown role", types.StringUnknown(), types.StringNull(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := makeConfig(t, schema, &AccessResourceModel{
+				ID:       types.StringNull(),
+				RoleID:   tt.role,
+				UserID:   tt.user,
+				PolicyID: types.StringValue("policy-1"),
+				Sort:     types.Int64Null(),
+			})
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: config}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildAccessInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildAccessInput(t *testing.T) {
+	t.Run("role attachment", func(t *testing.T) {
+		input := buildAccessInput(AccessResourceModel{
+			RoleID:   types.StringValue("role-1"),
+			UserID:   types.StringNull(),
+			PolicyID: types.StringValue("policy-1"),
+			Sort:     types.Int64Unknown(),
+		})
+
+		assert.Equal(t, "role-1", input["role"])
+		assert.Equal(t, "policy-1", input["policy"])
+		assert.NotContains(t, input, "user")
+		assert.NotContains(t, input, "sort")
+	})
+
+	t.Run("user attachment with sort", func(t *testing.T) {
+		input := buildAccessInput(AccessResourceModel{
+			RoleID:   types.StringNull(),
+			UserID:   types.StringValue("user-1"),
+			PolicyID: types.StringValue("policy-1"),
+			Sort:     types.Int64Value(2),
+		})
+
+		assert.Equal(t, "user-1", input["user"])
+		assert.Equal(t, int64(2), input["sort"])
+		assert.NotContains(t, input, "role")
+	})
+}
+
+func TestAccessAPIResponseToModel(t *testing.T) {
+	sort := int64(1)
+	model := (&accessAPIResponse{ID: "access-1", Role: "role-1", Policy: "policy-1", Sort: &sort}).toModel()
+
+	assert.Equal(t, "access-1", model.ID.ValueString())
+	assert.Equal(t, "role-1", model.RoleID.ValueString())
+	assert.True(t, model.UserID.IsNull())
+	assert.Equal(t, "policy-1", model.PolicyID.ValueString())
+	assert.Equal(t, int64(1), model.Sort.ValueInt64())
+
+	model = (&accessAPIResponse{ID: "access-2", User: "user-1", Policy: "policy-1"}).toModel()
+	assert.True(t, model.RoleID.IsNull())
+	assert.True(t, model.Sort.IsNull())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestAccessResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/access", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "role-1", body["role"])
+		assert.Equal(t, "policy-1", body["policy"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "access-1", "role": "role-1", "user": nil, "policy": "policy-1", "sort": 1,
+			},
+		}), nil
+	})
+
+	r := &AccessResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &AccessResourceModel{
+		ID:       types.StringUnknown(),
+		RoleID:   types.StringValue("role-1"),
+		UserID:   types.StringNull(),
+		PolicyID: types.StringValue("policy-1"),
+		Sort:     types.Int64Unknown(),
+	})
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result AccessResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "access-1", result.ID.ValueString())
+	assert.Equal(t, int64(1), result.Sort.ValueInt64())
+}
+
+func TestAccessResource_Update(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/access/access-1", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, float64(5), body["sort"])
+		assert.NotContains(t, body, "policy")
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "access-1", "role": "role-1", "policy": "policy-1", "sort": 5},
+		}), nil
+	})
+
+	r := &AccessResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &AccessResourceModel{
+		ID:       types.StringValue("access-1"),
+		RoleID:   types.StringValue("role-1"),
+		UserID:   types.StringNull(),
+		PolicyID: types.StringValue("policy-1"),
+		Sort:     types.Int64Value(5),
+	})
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+}
+
+func TestAccessResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/access/access-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &AccessResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &AccessResourceModel{
+		ID:       types.StringValue("access-1"),
+		RoleID:   types.StringValue("role-1"),
+		UserID:   types.StringNull(),
+		PolicyID: types.StringValue("policy-1"),
+		Sort:     types.Int64Null(),
+	})
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
+
+// ---------------------------------------------------------------------------
+// ImportState
+// ---------------------------------------------------------------------------
+
+func TestAccessResource_ImportState(t *testing.T) {
+	t.Run("by access id", func(t *testing.T) {
+		r := &AccessResource{}
+		schema := getResourceSchema(t, r)
+
+		resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "access-1"}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "ImportState diagnostics: %v", resp.Diagnostics)
+
+		var id types.String
+		resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+		assert.Equal(t, "access-1", id.ValueString())
+	})
+
+	t.Run("by role and policy", func(t *testing.T) {
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/access", req.URL.Path)
+			assert.Equal(t, `{"policy":{"_eq":"policy-1"},"role":{"_eq":"role-1"}}`, req.URL.Query().Get("filter"))
+
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []map[string]interface{}{{"id": "access-9"}},
+			}), nil
+		})
+
+		r := &AccessResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "role-1/policy-1"}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "ImportState diagnostics: %v", resp.Diagnostics)
+
+		var id types.String
+		resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+		assert.Equal(t, "access-9", id.ValueString())
+	})
+
+	t.Run("not attached", func(t *testing.T) {
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			return mockJSONResponse(200, map[string]interface{}{"data": []interface{}{}}), nil
+		})
+
+		r := &AccessResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "role-1/policy-1"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("malformed id", func(t *testing.T) {
+		r := &AccessResource{}
+		schema := getResourceSchema(t, r)
+
+		resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "role-1/"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
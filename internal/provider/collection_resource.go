@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -18,6 +24,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CollectionResource{}
 var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
 
 // NewCollectionResource creates a new collection resource.
 func NewCollectionResource() resource.Resource {
@@ -31,14 +39,52 @@ type CollectionResource struct {
 
 // CollectionResourceModel describes the resource data model.
 type CollectionResourceModel struct {
-	Collection types.String `tfsdk:"collection"`
-	Icon       types.String `tfsdk:"icon"`
-	Note       types.String `tfsdk:"note"`
-	Hidden     types.Bool   `tfsdk:"hidden"`
-	Singleton  types.Bool   `tfsdk:"singleton"`
-	SortField  types.String `tfsdk:"sort_field"`
-	Archive    types.String `tfsdk:"archive_field"`
-	Color      types.String `tfsdk:"color"`
+	Collection            types.String `tfsdk:"collection"`
+	Kind                  types.String `tfsdk:"kind"`
+	PrimaryKey            types.Object `tfsdk:"primary_key"`
+	Icon                  types.String `tfsdk:"icon"`
+	Note                  types.String `tfsdk:"note"`
+	DisplayTemplate       types.String `tfsdk:"display_template"`
+	Hidden                types.Bool   `tfsdk:"hidden"`
+	Singleton             types.Bool   `tfsdk:"singleton"`
+	Translations          types.String `tfsdk:"translations"`
+	SortField             types.String `tfsdk:"sort_field"`
+	Archive               types.String `tfsdk:"archive_field"`
+	ArchiveAppFilter      types.Bool   `tfsdk:"archive_app_filter"`
+	ArchiveValue          types.String `tfsdk:"archive_value"`
+	UnarchiveValue        types.String `tfsdk:"unarchive_value"`
+	Accountability        types.String `tfsdk:"accountability"`
+	Color                 types.String `tfsdk:"color"`
+	ItemDuplicationFields types.List   `tfsdk:"item_duplication_fields"`
+	Sort                  types.Int64  `tfsdk:"sort"`
+	Group                 types.String `tfsdk:"group"`
+	Collapse              types.String `tfsdk:"collapse"`
+	PreviewURL            types.String `tfsdk:"preview_url"`
+	Versioning            types.Bool   `tfsdk:"versioning"`
+	ForceDestroy          types.Bool   `tfsdk:"force_destroy"`
+}
+
+// collectionPrimaryKeyModel describes the primary_key attribute.
+type collectionPrimaryKeyModel struct {
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	AutoIncrement types.Bool   `tfsdk:"auto_increment"`
+}
+
+// collectionReplaceDescription describes the RequiresReplaceIf plan modifiers of kind and
+// primary_key. A null prior value does not force replacement: states written before these
+// attributes existed have none, and filling them in must not drop the table.
+const collectionReplaceDescription = "Changing this value forces a new resource, unless it was not set in the prior state."
+
+// collectionAccountabilityNone is the accountability value that turns off tracking. Directus
+// stores it as null, which Terraform cannot tell apart from an unset computed attribute.
+const collectionAccountabilityNone = "none"
+
+// collectionPrimaryKeyAttrTypes are the attribute types of the primary_key object.
+var collectionPrimaryKeyAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"type":           types.StringType,
+	"auto_increment": types.BoolType,
 }
 
 func (r *CollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +104,60 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "`table` creates a database table. `folder` creates a virtual collection without a table, " +
+					"used to group other collections in the Data Studio navigation. Defaults to `table`. " +
+					"Changing this forces a new resource.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("table"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						collectionReplaceDescription, collectionReplaceDescription,
+					),
+				},
+			},
+			"primary_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "The primary key field created with the table. Defaults to an auto-incremented integer `id`. " +
+					"Not available for folders. Changing this forces a new resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+					objectplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						collectionReplaceDescription, collectionReplaceDescription,
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the primary key field. Defaults to `id`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("id"),
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the primary key: `integer`, `uuid` or `string`. Defaults to `integer`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("integer"),
+					},
+					"auto_increment": schema.BoolAttribute{
+						MarkdownDescription: "Whether an integer primary key is generated by the database. " +
+							"Defaults to `true` for `integer` and `false` otherwise.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
 			"icon": schema.StringAttribute{
 				MarkdownDescription: "The name of a Google Material Design Icon assigned to this collection.",
 				Optional:            true,
@@ -66,6 +166,10 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "A short description displayed in the Data Studio.",
 				Optional:            true,
 			},
+			"display_template": schema.StringAttribute{
+				MarkdownDescription: "How items of this collection are displayed when referenced from other collections, e.g. `{{title}} ({{status}})`.",
+				Optional:            true,
+			},
 			"hidden": schema.BoolAttribute{
 				MarkdownDescription: "Whether this collection is hidden from the Data Studio.",
 				Optional:            true,
@@ -82,22 +186,208 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"translations": schema.StringAttribute{
+				MarkdownDescription: "JSON array of collection name translations. Each entry has a `language` and a `translation`, " +
+					"and optionally `singular` and `plural`.",
+				Optional: true,
+			},
 			"sort_field": schema.StringAttribute{
 				MarkdownDescription: "The field used for manual sorting of items.",
 				Optional:            true,
 			},
 			"archive_field": schema.StringAttribute{
-				MarkdownDescription: "The field used to archive items (soft delete).",
+				MarkdownDescription: "The field used to archive items (soft delete). Requires `archive_value` and `unarchive_value`.",
+				Optional:            true,
+			},
+			"archive_app_filter": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Data Studio hides archived items by default. Directus defaults this to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"archive_value": schema.StringAttribute{
+				MarkdownDescription: "The value written to `archive_field` when an item is archived.",
+				Optional:            true,
+			},
+			"unarchive_value": schema.StringAttribute{
+				MarkdownDescription: "The value written to `archive_field` when an item is unarchived.",
 				Optional:            true,
 			},
+			"accountability": schema.StringAttribute{
+				MarkdownDescription: "What is tracked for this collection: `all` (activity and revisions), `activity` (activity only), " +
+					"or `" + collectionAccountabilityNone + "` (nothing). Directus defaults this to `all`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"color": schema.StringAttribute{
 				MarkdownDescription: "A color hex code associated with this collection (e.g., #6644FF).",
 				Optional:            true,
 			},
+			"item_duplication_fields": schema.ListAttribute{
+				MarkdownDescription: "The fields copied when an item is duplicated in the Data Studio.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"sort": schema.Int64Attribute{
+				MarkdownDescription: "The position of this collection in the Data Studio navigation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The parent collection (usually a folder) this collection is nested under in the Data Studio.",
+				Optional:            true,
+			},
+			"collapse": schema.StringAttribute{
+				MarkdownDescription: "How the collection's group is shown in the Data Studio navigation: `open`, `closed` or `locked`. " +
+					"Directus defaults this to `open`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"preview_url": schema.StringAttribute{
+				MarkdownDescription: "URL template for live previews of items, e.g. `https://example.com/preview/{{id}}`.",
+				Optional:            true,
+			},
+			"versioning": schema.BoolAttribute{
+				MarkdownDescription: "Whether content versioning is enabled for this collection.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the collection even if it still contains items. " +
+					"When `false`, destroying or replacing a collection with items fails. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
+// ValidateConfig checks enumerated values and that archiving is fully configured.
+func (r *CollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateStringOneOf(data.Accountability, "all", "activity", collectionAccountabilityNone); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("accountability"), "Invalid Accountability", err.Error())
+	}
+	if err := validateStringOneOf(data.Collapse, "open", "closed", "locked"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("collapse"), "Invalid Collapse", err.Error())
+	}
+	if err := validateStringOneOf(data.Kind, "table", "folder"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("kind"), "Invalid Kind", err.Error())
+	}
+	if data.Kind.ValueString() == "folder" {
+		if !data.PrimaryKey.IsNull() && !data.PrimaryKey.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(path.Root("primary_key"), "Invalid Primary Key", "Folders have no table and cannot define a primary key.")
+		}
+		if !data.SortField.IsNull() || !data.Archive.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("kind"), "Invalid Kind", "Folders have no fields, so sort_field and archive_field cannot be set.")
+		}
+	}
+	if pk := collectionPrimaryKeyFromObject(data.PrimaryKey); pk != nil {
+		if err := validateStringOneOf(pk.Type, "integer", "uuid", "string"); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("primary_key").AtName("type"), "Invalid Primary Key Type", err.Error())
+		}
+		if pk.AutoIncrement.ValueBool() && !pk.Type.IsNull() && !pk.Type.IsUnknown() && pk.Type.ValueString() != "integer" {
+			resp.Diagnostics.AddAttributeError(path.Root("primary_key").AtName("auto_increment"), "Invalid Primary Key", "auto_increment is only supported for integer primary keys.")
+		}
+	}
+	if err := validateCollectionTranslations(data.Translations); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("translations"), "Invalid Translations", err.Error())
+	}
+
+	if !data.Group.IsNull() && !data.Group.IsUnknown() && data.Group.ValueString() == data.Collection.ValueString() {
+		resp.Diagnostics.AddAttributeError(path.Root("group"), "Invalid Group", "A collection cannot be its own group.")
+	}
+
+	// Archive values are only meaningful together with an archive field, and an archive field
+	// without values would make the Data Studio archive action write nulls.
+	if data.Archive.IsUnknown() {
+		return
+	}
+	archiveValues := []struct {
+		name  string
+		value types.String
+	}{
+		{"archive_value", data.ArchiveValue},
+		{"unarchive_value", data.UnarchiveValue},
+	}
+	if !data.Archive.IsNull() {
+		for _, v := range archiveValues {
+			if v.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(v.name), "Missing Archive Value", v.name+" is required when archive_field is set.")
+			}
+		}
+		return
+	}
+	for _, v := range archiveValues {
+		if !v.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(v.name), "Invalid Archive Value", v.name+" can only be set together with archive_field.")
+		}
+	}
+	if !data.ArchiveAppFilter.IsNull() && !data.ArchiveAppFilter.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("archive_app_filter"), "Invalid Archive App Filter", "archive_app_filter can only be set together with archive_field.")
+	}
+}
+
+// ModifyPlan warns when a collection that still contains items is about to be replaced,
+// since replacing a collection drops its table.
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !collectionRequiresReplace(plan, state) || state.Kind.ValueString() == "folder" {
+		return
+	}
+
+	collection := state.Collection.ValueString()
+	count, err := r.countItems(ctx, collection)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Collection Will Be Replaced",
+			fmt.Sprintf("Collection %s will be deleted and recreated, dropping its table. The number of items could not be determined: %s", collection, err.Error()),
+		)
+		return
+	}
+	if count == 0 {
+		return
+	}
+
+	detail := fmt.Sprintf("Collection %s will be deleted and recreated, dropping its table with %d item(s).", collection, count)
+	if !state.ForceDestroy.ValueBool() {
+		detail += " The apply will fail unless force_destroy = true has been applied beforehand."
+	}
+	resp.Diagnostics.AddWarning("Collection With Items Will Be Replaced", detail)
+}
+
 func (r *CollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -128,6 +418,9 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Resolve the primary key defaults so that they are sent and stored as planned
+	data.PrimaryKey = resolveCollectionPrimaryKey(data)
+
 	// Build create input
 	createInput := buildCollectionInput(data, true)
 
@@ -144,19 +437,8 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Convert API response to model
-	createdCollection := result.Data.toModel()
-	data.Collection = createdCollection.Collection
-	data.Icon = createdCollection.Icon
-	data.Note = createdCollection.Note
-	data.Hidden = createdCollection.Hidden
-	data.Singleton = createdCollection.Singleton
-	data.SortField = createdCollection.SortField
-	data.Archive = createdCollection.Archive
-	data.Color = createdCollection.Color
-
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, result.Data.toModel(data))...)
 }
 
 func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -182,18 +464,28 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Convert API response to model
-	readCollection := result.Data.toModel()
-	data.Icon = readCollection.Icon
-	data.Note = readCollection.Note
-	data.Hidden = readCollection.Hidden
-	data.Singleton = readCollection.Singleton
-	data.SortField = readCollection.SortField
-	data.Archive = readCollection.Archive
-	data.Color = readCollection.Color
+	readCollection := result.Data.toModel(data)
+
+	// The primary key is not part of the collection response. Look it up when it is not
+	// known yet, e.g. after import.
+	if readCollection.Kind.ValueString() == "table" && readCollection.PrimaryKey.IsNull() {
+		var fields struct {
+			Data []collectionFieldResponse `json:"data"`
+		}
+
+		if err := r.client.Get(ctx, "fields", data.Collection.ValueString(), &fields); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Collection",
+				"Could not read fields of collection "+data.Collection.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		readCollection.PrimaryKey = collectionPrimaryKeyFromFields(fields.Data)
+	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, readCollection)...)
 }
 
 func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -222,18 +514,8 @@ func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Convert API response to model
-	updatedCollection := result.Data.toModel()
-	data.Icon = updatedCollection.Icon
-	data.Note = updatedCollection.Note
-	data.Hidden = updatedCollection.Hidden
-	data.Singleton = updatedCollection.Singleton
-	data.SortField = updatedCollection.SortField
-	data.Archive = updatedCollection.Archive
-	data.Color = updatedCollection.Color
-
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, result.Data.toModel(data))...)
 }
 
 func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -246,6 +528,26 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Refuse to drop a table that still contains items unless forced
+	if !data.ForceDestroy.ValueBool() && data.Kind.ValueString() != "folder" {
+		count, err := r.countItems(ctx, data.Collection.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Collection",
+				"Could not count the items of collection "+data.Collection.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if count > 0 {
+			resp.Diagnostics.AddError(
+				"Collection Is Not Empty",
+				fmt.Sprintf("Collection %s still contains %d item(s). Set force_destroy = true and apply it before destroying the collection.",
+					data.Collection.ValueString(), count),
+			)
+			return
+		}
+	}
+
 	// Delete collection via API
 	if err := r.client.Delete(ctx, "collections", data.Collection.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
@@ -260,6 +562,34 @@ func (r *CollectionResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("collection"), req, resp)
 }
 
+// countItems returns the number of items in a collection using an aggregate query.
+func (r *CollectionResource) countItems(ctx context.Context, collection string) (int64, error) {
+	var result struct {
+		Data []struct {
+			Count flexibleInt64 `json:"count"`
+		} `json:"data"`
+	}
+
+	if err := r.client.ListWithParams(ctx, collection, map[string]string{"aggregate[count]": "*"}, &result); err != nil {
+		return 0, err
+	}
+	if len(result.Data) == 0 {
+		return 0, nil
+	}
+	return int64(result.Data[0].Count), nil
+}
+
+// collectionRequiresReplace reports whether the plan replaces the collection, i.e. whether
+// an attribute with a RequiresReplace plan modifier changes.
+func collectionRequiresReplace(plan, state CollectionResourceModel) bool {
+	changed := func(planned, prior attr.Value) bool {
+		return !planned.IsUnknown() && !prior.IsNull() && !planned.Equal(prior)
+	}
+	return changed(plan.Collection, state.Collection) ||
+		changed(plan.Kind, state.Kind) ||
+		changed(plan.PrimaryKey, state.PrimaryKey)
+}
+
 // collectionAPIResponse represents the API response for collection operations with plain Go types
 type collectionAPIResponse struct {
 	Collection string                    `json:"collection"`
@@ -268,14 +598,26 @@ type collectionAPIResponse struct {
 }
 
 type collectionMetaResponse struct {
-	Collection   string `json:"collection,omitempty"`
-	Icon         string `json:"icon,omitempty"`
-	Note         string `json:"note,omitempty"`
-	Hidden       bool   `json:"hidden,omitempty"`
-	Singleton    bool   `json:"singleton,omitempty"`
-	SortField    string `json:"sort_field,omitempty"`
-	ArchiveField string `json:"archive_field,omitempty"`
-	Color        string `json:"color,omitempty"`
+	Collection            string          `json:"collection,omitempty"`
+	Icon                  string          `json:"icon,omitempty"`
+	Note                  string          `json:"note,omitempty"`
+	DisplayTemplate       string          `json:"display_template,omitempty"`
+	Hidden                bool            `json:"hidden,omitempty"`
+	Singleton             bool            `json:"singleton,omitempty"`
+	Translations          json.RawMessage `json:"translations,omitempty"`
+	SortField             string          `json:"sort_field,omitempty"`
+	ArchiveField          string          `json:"archive_field,omitempty"`
+	ArchiveAppFilter      *bool           `json:"archive_app_filter,omitempty"`
+	ArchiveValue          string          `json:"archive_value,omitempty"`
+	UnarchiveValue        string          `json:"unarchive_value,omitempty"`
+	Accountability        string          `json:"accountability,omitempty"`
+	Color                 string          `json:"color,omitempty"`
+	ItemDuplicationFields []string        `json:"item_duplication_fields,omitempty"`
+	Sort                  *int64          `json:"sort,omitempty"`
+	Group                 string          `json:"group,omitempty"`
+	Collapse              string          `json:"collapse,omitempty"`
+	PreviewURL            string          `json:"preview_url,omitempty"`
+	Versioning            bool            `json:"versioning,omitempty"`
 }
 
 type collectionSchemaResponse struct {
@@ -283,34 +625,105 @@ type collectionSchemaResponse struct {
 	Comment string `json:"comment,omitempty"`
 }
 
-// toModel converts collectionAPIResponse to CollectionResourceModel
-func (c *collectionAPIResponse) toModel() *CollectionResourceModel {
+// collectionFieldResponse is the part of a field returned by GET /fields/{collection}
+// that is needed to identify the primary key.
+type collectionFieldResponse struct {
+	Field  string `json:"field"`
+	Type   string `json:"type"`
+	Schema *struct {
+		IsPrimaryKey     bool `json:"is_primary_key"`
+		HasAutoIncrement bool `json:"has_auto_increment"`
+	} `json:"schema,omitempty"`
+}
+
+// toModel converts collectionAPIResponse to CollectionResourceModel.
+// The prior model is used to keep semantically equal translations JSON as configured.
+func (c *collectionAPIResponse) toModel(prior CollectionResourceModel) *CollectionResourceModel {
 	collection := &CollectionResourceModel{
-		Collection: types.StringValue(c.Collection),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
-		Icon:       types.StringNull(),
-		Note:       types.StringNull(),
-		SortField:  types.StringNull(),
-		Archive:    types.StringNull(),
-		Color:      types.StringNull(),
+		Collection:            types.StringValue(c.Collection),
+		Kind:                  types.StringValue("table"),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		Icon:                  types.StringNull(),
+		Note:                  types.StringNull(),
+		DisplayTemplate:       types.StringNull(),
+		Translations:          types.StringNull(),
+		SortField:             types.StringNull(),
+		Archive:               types.StringNull(),
+		ArchiveAppFilter:      types.BoolValue(true),
+		ArchiveValue:          types.StringNull(),
+		UnarchiveValue:        types.StringNull(),
+		Accountability:        types.StringNull(),
+		Color:                 types.StringNull(),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		Sort:                  types.Int64Null(),
+		Group:                 types.StringNull(),
+		Collapse:              types.StringValue("open"),
+		PreviewURL:            types.StringNull(),
+		Versioning:            types.BoolValue(false),
+		ForceDestroy:          prior.ForceDestroy,
+	}
+
+	// force_destroy only exists in Terraform
+	if prior.ForceDestroy.IsNull() || prior.ForceDestroy.IsUnknown() {
+		collection.ForceDestroy = types.BoolValue(false)
 	}
 
 	// Extract values from meta if present
 	if c.Meta != nil {
 		collection.Icon = stringOrNull(c.Meta.Icon)
 		collection.Note = stringOrNull(c.Meta.Note)
+		collection.DisplayTemplate = stringOrNull(c.Meta.DisplayTemplate)
 		collection.Hidden = types.BoolValue(c.Meta.Hidden)
 		collection.Singleton = types.BoolValue(c.Meta.Singleton)
+		collection.Translations = jsonStringValue(prior.Translations, c.Meta.Translations)
 		collection.SortField = stringOrNull(c.Meta.SortField)
 		collection.Archive = stringOrNull(c.Meta.ArchiveField)
+		if c.Meta.ArchiveAppFilter != nil {
+			collection.ArchiveAppFilter = types.BoolValue(*c.Meta.ArchiveAppFilter)
+		}
+		collection.ArchiveValue = stringOrNull(c.Meta.ArchiveValue)
+		collection.UnarchiveValue = stringOrNull(c.Meta.UnarchiveValue)
+		collection.Accountability = types.StringValue(c.Meta.Accountability)
+		if c.Meta.Accountability == "" {
+			collection.Accountability = types.StringValue(collectionAccountabilityNone)
+		}
 		collection.Color = stringOrNull(c.Meta.Color)
+		collection.ItemDuplicationFields = stringListOrNull(c.Meta.ItemDuplicationFields)
+		if c.Meta.Sort != nil {
+			collection.Sort = types.Int64Value(*c.Meta.Sort)
+		}
+		collection.Group = stringOrNull(c.Meta.Group)
+		if c.Meta.Collapse != "" {
+			collection.Collapse = types.StringValue(c.Meta.Collapse)
+		}
+		collection.PreviewURL = stringOrNull(c.Meta.PreviewURL)
+		collection.Versioning = types.BoolValue(c.Meta.Versioning)
+	}
+
+	// Folders are collections without a database schema. Kind and primary key cannot
+	// change without replacement, so known prior values are kept.
+	if c.Schema == nil {
+		collection.Kind = types.StringValue("folder")
+	}
+	if !prior.Kind.IsNull() && !prior.Kind.IsUnknown() {
+		collection.Kind = prior.Kind
+	}
+	if collection.Kind.ValueString() == "table" && !prior.PrimaryKey.IsNull() && !prior.PrimaryKey.IsUnknown() {
+		collection.PrimaryKey = prior.PrimaryKey
+	}
+
+	// Directus returns an empty translations array for collections without translations.
+	if prior.Translations.IsNull() && collection.Translations.ValueString() == "[]" {
+		collection.Translations = types.StringNull()
 	}
 
 	return collection
 }
 
-// buildCollectionInput constructs the input from the resource model (used for both create and update)
+// buildCollectionInput constructs the input from the resource model (used for both create and update).
+// On update, optional meta fields removed from the configuration are sent as null to clear them.
 func buildCollectionInput(data CollectionResourceModel, isCreate bool) map[string]interface{} {
 	input := make(map[string]interface{})
 
@@ -318,19 +731,62 @@ func buildCollectionInput(data CollectionResourceModel, isCreate bool) map[strin
 	if isCreate {
 		input["collection"] = data.Collection.ValueString()
 		// schema: {} is required to create a real database table.
-		// Without it, Directus creates a virtual collection (folder) with no backing table.
-		input["schema"] = map[string]interface{}{}
+		// With schema: null, Directus creates a virtual collection (folder) with no backing table.
+		if data.Kind.ValueString() == "folder" {
+			input["schema"] = nil
+		} else {
+			input["schema"] = map[string]interface{}{}
+			if pk := collectionPrimaryKeyFromObject(data.PrimaryKey); pk != nil {
+				input["fields"] = []interface{}{collectionPrimaryKeyField(*pk)}
+			}
+		}
+	}
+
+	setString := setNullableStringField
+	if isCreate {
+		setString = setStringField
 	}
 
 	// Build meta object
 	meta := make(map[string]interface{})
-	setStringField(meta, "icon", data.Icon)
-	setStringField(meta, "note", data.Note)
+	setString(meta, "icon", data.Icon)
+	setString(meta, "note", data.Note)
+	setString(meta, "display_template", data.DisplayTemplate)
 	setBoolField(meta, "hidden", data.Hidden)
 	setBoolField(meta, "singleton", data.Singleton)
-	setStringField(meta, "sort_field", data.SortField)
-	setStringField(meta, "archive_field", data.Archive)
-	setStringField(meta, "color", data.Color)
+	setString(meta, "sort_field", data.SortField)
+	setString(meta, "archive_field", data.Archive)
+	setBoolField(meta, "archive_app_filter", data.ArchiveAppFilter)
+	setString(meta, "archive_value", data.ArchiveValue)
+	setString(meta, "unarchive_value", data.UnarchiveValue)
+	if data.Accountability.ValueString() == collectionAccountabilityNone {
+		meta["accountability"] = nil
+	} else {
+		setStringField(meta, "accountability", data.Accountability)
+	}
+	setString(meta, "color", data.Color)
+	setInt64Field(meta, "sort", data.Sort)
+	setString(meta, "group", data.Group)
+	setStringField(meta, "collapse", data.Collapse)
+	setString(meta, "preview_url", data.PreviewURL)
+	setBoolField(meta, "versioning", data.Versioning)
+
+	setJSONField(meta, "translations", data.Translations)
+	if !isCreate && data.Translations.IsNull() {
+		meta["translations"] = nil
+	}
+
+	if !data.ItemDuplicationFields.IsNull() && !data.ItemDuplicationFields.IsUnknown() {
+		fields := make([]string, 0, len(data.ItemDuplicationFields.Elements()))
+		for _, element := range data.ItemDuplicationFields.Elements() {
+			if value, ok := element.(types.String); ok {
+				fields = append(fields, value.ValueString())
+			}
+		}
+		meta["item_duplication_fields"] = fields
+	} else if !isCreate && data.ItemDuplicationFields.IsNull() {
+		meta["item_duplication_fields"] = nil
+	}
 
 	if len(meta) > 0 {
 		input["meta"] = meta
@@ -338,3 +794,120 @@ func buildCollectionInput(data CollectionResourceModel, isCreate bool) map[strin
 
 	return input
 }
+
+// collectionPrimaryKeyFromObject returns the primary_key attributes, or nil if the object is null or unknown.
+func collectionPrimaryKeyFromObject(value types.Object) *collectionPrimaryKeyModel {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	attrs := value.Attributes()
+	pk := &collectionPrimaryKeyModel{
+		Name:          types.StringNull(),
+		Type:          types.StringNull(),
+		AutoIncrement: types.BoolNull(),
+	}
+	if v, ok := attrs["name"].(types.String); ok {
+		pk.Name = v
+	}
+	if v, ok := attrs["type"].(types.String); ok {
+		pk.Type = v
+	}
+	if v, ok := attrs["auto_increment"].(types.Bool); ok {
+		pk.AutoIncrement = v
+	}
+	return pk
+}
+
+// collectionPrimaryKeyValue converts a primary key to its object value.
+func collectionPrimaryKeyValue(pk collectionPrimaryKeyModel) types.Object {
+	return types.ObjectValueMust(collectionPrimaryKeyAttrTypes, map[string]attr.Value{
+		"name":           pk.Name,
+		"type":           pk.Type,
+		"auto_increment": pk.AutoIncrement,
+	})
+}
+
+// resolveCollectionPrimaryKey fills in the defaults of the planned primary key.
+// Folders have no primary key.
+func resolveCollectionPrimaryKey(data CollectionResourceModel) types.Object {
+	if data.Kind.ValueString() == "folder" {
+		return types.ObjectNull(collectionPrimaryKeyAttrTypes)
+	}
+
+	pk := collectionPrimaryKeyFromObject(data.PrimaryKey)
+	if pk == nil {
+		pk = &collectionPrimaryKeyModel{Name: types.StringUnknown(), Type: types.StringUnknown(), AutoIncrement: types.BoolUnknown()}
+	}
+	if pk.Name.IsNull() || pk.Name.IsUnknown() {
+		pk.Name = types.StringValue("id")
+	}
+	if pk.Type.IsNull() || pk.Type.IsUnknown() {
+		pk.Type = types.StringValue("integer")
+	}
+	if pk.AutoIncrement.IsNull() || pk.AutoIncrement.IsUnknown() {
+		pk.AutoIncrement = types.BoolValue(pk.Type.ValueString() == "integer")
+	}
+	return collectionPrimaryKeyValue(*pk)
+}
+
+// collectionPrimaryKeyField builds the primary key field sent in the fields of a new collection.
+// The field definitions mirror the ones the Data Studio sends.
+func collectionPrimaryKeyField(pk collectionPrimaryKeyModel) map[string]interface{} {
+	field := map[string]interface{}{
+		"field": pk.Name.ValueString(),
+		"type":  pk.Type.ValueString(),
+	}
+
+	switch pk.Type.ValueString() {
+	case "uuid":
+		field["meta"] = map[string]interface{}{"hidden": true, "readonly": true, "interface": "input", "special": []string{"uuid"}}
+		field["schema"] = map[string]interface{}{"is_primary_key": true, "length": 36, "has_auto_increment": false}
+	case "string":
+		field["meta"] = map[string]interface{}{"hidden": false, "readonly": false, "interface": "input"}
+		field["schema"] = map[string]interface{}{"is_primary_key": true, "length": 255, "has_auto_increment": false}
+	default:
+		autoIncrement := pk.AutoIncrement.IsNull() || pk.AutoIncrement.IsUnknown() || pk.AutoIncrement.ValueBool()
+		field["meta"] = map[string]interface{}{"hidden": autoIncrement, "readonly": autoIncrement, "interface": "input"}
+		field["schema"] = map[string]interface{}{"is_primary_key": true, "has_auto_increment": autoIncrement}
+	}
+
+	return field
+}
+
+// collectionPrimaryKeyFromFields finds the primary key among the fields of a collection.
+func collectionPrimaryKeyFromFields(fields []collectionFieldResponse) types.Object {
+	for _, field := range fields {
+		if field.Schema == nil || !field.Schema.IsPrimaryKey {
+			continue
+		}
+		return collectionPrimaryKeyValue(collectionPrimaryKeyModel{
+			Name:          types.StringValue(field.Field),
+			Type:          types.StringValue(field.Type),
+			AutoIncrement: types.BoolValue(field.Schema.HasAutoIncrement),
+		})
+	}
+	return types.ObjectNull(collectionPrimaryKeyAttrTypes)
+}
+
+// validateCollectionTranslations checks that translations is a JSON array of objects
+// that each name a language.
+func validateCollectionTranslations(value types.String) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var translations []map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &translations); err != nil {
+		return fmt.Errorf("value must be a JSON array of objects: %w", err)
+	}
+	for i, translation := range translations {
+		if language, ok := translation["language"].(string); !ok || language == "" {
+			return fmt.Errorf("translation %d must have a language", i)
+		}
+		if _, ok := translation["translation"].(string); !ok {
+			return fmt.Errorf("translation %d must have a translation string", i)
+		}
+	}
+	return nil
+}
@@ -1,7 +1,12 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -59,6 +64,18 @@ resource "directus_collection" "full" {
   sort_field    = "sort_order"
   archive_field = "status"
   color         = "#6644FF"
+
+  archive_value      = "archived"
+  unarchive_value    = "draft"
+  archive_app_filter = false
+  display_template   = "{{title}}"
+  accountability     = "activity"
+  collapse           = "closed"
+  preview_url        = "https://example.com/preview/{{id}}"
+  versioning         = true
+  translations = jsonencode([
+    { language = "de-DE", translation = "Warenkorb", singular = "Warenkorb", plural = "Warenkörbe" },
+  ])
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -70,6 +87,14 @@ resource "directus_collection" "full" {
 					resource.TestCheckResourceAttr("directus_collection.full", "sort_field", "sort_order"),
 					resource.TestCheckResourceAttr("directus_collection.full", "archive_field", "status"),
 					resource.TestCheckResourceAttr("directus_collection.full", "color", "#6644FF"),
+					resource.TestCheckResourceAttr("directus_collection.full", "archive_value", "archived"),
+					resource.TestCheckResourceAttr("directus_collection.full", "unarchive_value", "draft"),
+					resource.TestCheckResourceAttr("directus_collection.full", "archive_app_filter", "false"),
+					resource.TestCheckResourceAttr("directus_collection.full", "display_template", "{{title}}"),
+					resource.TestCheckResourceAttr("directus_collection.full", "accountability", "activity"),
+					resource.TestCheckResourceAttr("directus_collection.full", "collapse", "closed"),
+					resource.TestCheckResourceAttr("directus_collection.full", "versioning", "true"),
+					resource.TestCheckResourceAttrSet("directus_collection.full", "translations"),
 				),
 			},
 			// ImportState
@@ -179,6 +204,9 @@ resource "directus_collection" "update" {
   color         = "#FF4444"
   sort_field    = "name"
   archive_field = "archived"
+
+  archive_value   = "true"
+  unarchive_value = "false"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -190,6 +218,21 @@ resource "directus_collection" "update" {
 					resource.TestCheckResourceAttr("directus_collection.update", "archive_field", "archived"),
 				),
 			},
+			// Remove optional fields
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_collection" "update" {
+  collection = "acc_test_update"
+  icon       = "newspaper"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("directus_collection.update", "note"),
+					resource.TestCheckNoResourceAttr("directus_collection.update", "color"),
+					resource.TestCheckNoResourceAttr("directus_collection.update", "archive_field"),
+					resource.TestCheckNoResourceAttr("directus_collection.update", "archive_value"),
+				),
+			},
 			// ImportState after update
 			{
 				ResourceName:                         "directus_collection.update",
@@ -305,6 +348,134 @@ resource "directus_collection" "hidden_singleton" {
 	})
 }
 
+func TestAccCollection_folderAndPrimaryKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_collection", "collections"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_collection" "folder" {
+  collection = "acc_test_folder"
+  kind       = "folder"
+  icon       = "folder"
+}
+
+resource "directus_collection" "uuid" {
+  collection = "acc_test_uuid_pk"
+  group      = directus_collection.folder.collection
+
+  primary_key = {
+    type = "uuid"
+  }
+}
+
+resource "directus_collection" "slug" {
+  collection = "acc_test_slug_pk"
+  group      = directus_collection.folder.collection
+
+  primary_key = {
+    name = "slug"
+    type = "string"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_collection.folder", "kind", "folder"),
+					resource.TestCheckNoResourceAttr("directus_collection.folder", "primary_key.name"),
+					resource.TestCheckResourceAttr("directus_collection.uuid", "kind", "table"),
+					resource.TestCheckResourceAttr("directus_collection.uuid", "primary_key.name", "id"),
+					resource.TestCheckResourceAttr("directus_collection.uuid", "primary_key.type", "uuid"),
+					resource.TestCheckResourceAttr("directus_collection.uuid", "primary_key.auto_increment", "false"),
+					resource.TestCheckResourceAttr("directus_collection.uuid", "group", "acc_test_folder"),
+					resource.TestCheckResourceAttr("directus_collection.slug", "primary_key.name", "slug"),
+					resource.TestCheckResourceAttr("directus_collection.slug", "primary_key.type", "string"),
+				),
+			},
+			// ImportState reads kind and primary key from Directus
+			{
+				ResourceName:                         "directus_collection.folder",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccCollectionImportStateIdFunc("directus_collection.folder"),
+				ImportStateVerifyIdentifierAttribute: "collection",
+			},
+			{
+				ResourceName:                         "directus_collection.slug",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccCollectionImportStateIdFunc("directus_collection.slug"),
+				ImportStateVerifyIdentifierAttribute: "collection",
+			},
+		},
+	})
+}
+
+// TestAccCollection_forceDestroy verifies that collections with items are only
+// deleted or replaced when force_destroy is set.
+func TestAccCollection_forceDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_collection", "collections"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccCollectionForceDestroyConfig("acc_test_force_v1", false),
+				Check:  resource.TestCheckResourceAttr("directus_collection.test", "force_destroy", "false"),
+			},
+			// Add an item outside Terraform, then try to replace the collection
+			{
+				PreConfig: func() {
+					testAccCreateItem(t, "acc_test_force_v1", map[string]interface{}{})
+				},
+				Config:      testAccProviderConfig() + testAccCollectionForceDestroyConfig("acc_test_force_v2", false),
+				ExpectError: regexp.MustCompile(`still contains 1 item`),
+			},
+			{
+				Config: testAccProviderConfig() + testAccCollectionForceDestroyConfig("acc_test_force_v1", true),
+				Check:  resource.TestCheckResourceAttr("directus_collection.test", "force_destroy", "true"),
+			},
+			{
+				Config: testAccProviderConfig() + testAccCollectionForceDestroyConfig("acc_test_force_v2", true),
+				Check:  resource.TestCheckResourceAttr("directus_collection.test", "collection", "acc_test_force_v2"),
+			},
+		},
+	})
+}
+
+func testAccCollectionForceDestroyConfig(collection string, forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "directus_collection" "test" {
+  collection    = %q
+  force_destroy = %t
+}
+`, collection, forceDestroy)
+}
+
+// testAccCreateItem adds an item to a collection through the API.
+func testAccCreateItem(t *testing.T, collection string, item map[string]interface{}) {
+	t.Helper()
+
+	body, _ := json.Marshal(item)
+	req, err := http.NewRequest("POST", os.Getenv("DIRECTUS_ENDPOINT")+"/items/"+collection, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create item request: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv("DIRECTUS_TOKEN"))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to create item in %s: %s", collection, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Directus returned HTTP %d when creating an item in %s", resp.StatusCode, collection)
+	}
+}
+
 // testAccCollectionImportStateIdFunc returns the collection name for import.
 // The collection resource uses the collection name as import ID.
 func testAccCollectionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
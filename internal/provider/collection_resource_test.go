@@ -3,14 +3,21 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
 )
 
 // ---------------------------------------------------------------------------
@@ -24,7 +31,11 @@ func TestCollectionResourceSchema(t *testing.T) {
 
 	require.False(t, schemaResp.Diagnostics.HasError())
 
-	expectedAttrs := []string{"collection", "icon", "note", "hidden", "singleton", "sort_field", "archive_field", "color"}
+	expectedAttrs := []string{
+		"collection", "icon", "note", "display_template", "hidden", "singleton", "translations",
+		"sort_field", "archive_field", "archive_app_filter", "archive_value", "unarchive_value",
+		"accountability", "color", "item_duplication_fields", "sort", "group", "collapse", "preview_url", "versioning",
+	}
 	for _, attr := range expectedAttrs {
 		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
 	}
@@ -38,6 +49,179 @@ func TestCollectionResourceMetadata(t *testing.T) {
 	assert.Equal(t, "directus_collection", metadataResp.TypeName)
 }
 
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newCollectionModel() *CollectionResourceModel {
+	return &CollectionResourceModel{
+		Collection:            types.StringValue("articles"),
+		Kind:                  types.StringNull(),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
+		Icon:                  types.StringNull(),
+		Note:                  types.StringNull(),
+		DisplayTemplate:       types.StringNull(),
+		Hidden:                types.BoolNull(),
+		Singleton:             types.BoolNull(),
+		Translations:          types.StringNull(),
+		SortField:             types.StringNull(),
+		Archive:               types.StringNull(),
+		ArchiveAppFilter:      types.BoolNull(),
+		ArchiveValue:          types.StringNull(),
+		UnarchiveValue:        types.StringNull(),
+		Accountability:        types.StringNull(),
+		Color:                 types.StringNull(),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		Sort:                  types.Int64Null(),
+		Group:                 types.StringNull(),
+		Collapse:              types.StringNull(),
+		PreviewURL:            types.StringNull(),
+		Versioning:            types.BoolNull(),
+		ForceDestroy:          types.BoolNull(),
+	}
+}
+
+func TestCollectionResource_RequiresReplace(t *testing.T) {
+	r := &CollectionResource{}
+	schema := getResourceSchema(t, r)
+	uuidKey := collectionPrimaryKeyValue(collectionPrimaryKeyModel{
+		Name: types.StringValue("id"), Type: types.StringValue("uuid"), AutoIncrement: types.BoolValue(false),
+	})
+
+	tests := []struct {
+		name        string
+		modifyState func(m *CollectionResourceModel)
+		modifyPlan  func(m *CollectionResourceModel)
+		wantKind    bool
+		wantPK      bool
+	}{
+		{
+			// States written before kind and primary_key existed have neither.
+			name:        "null prior values",
+			modifyState: func(m *CollectionResourceModel) {},
+			modifyPlan: func(m *CollectionResourceModel) {
+				m.Kind = types.StringValue("table")
+				m.PrimaryKey = types.ObjectUnknown(collectionPrimaryKeyAttrTypes)
+			},
+		},
+		{
+			name: "changed values",
+			modifyState: func(m *CollectionResourceModel) {
+				m.Kind = types.StringValue("table")
+				m.PrimaryKey = collectionPrimaryKeyValue(collectionPrimaryKeyModel{
+					Name: types.StringValue("id"), Type: types.StringValue("integer"), AutoIncrement: types.BoolValue(true),
+				})
+			},
+			modifyPlan: func(m *CollectionResourceModel) {
+				m.Kind = types.StringValue("folder")
+				m.PrimaryKey = uuidKey
+			},
+			wantKind: true,
+			wantPK:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateModel, planModel := newCollectionModel(), newCollectionModel()
+			tt.modifyState(stateModel)
+			tt.modifyPlan(planModel)
+			state := makeState(t, schema, stateModel)
+			plan := makePlan(t, schema, planModel)
+			config := tfsdk.Config{Schema: schema, Raw: plan.Raw}
+
+			kindResp := &planmodifier.StringResponse{PlanValue: planModel.Kind}
+			for _, m := range schema.Attributes["kind"].(rschema.StringAttribute).PlanModifiers {
+				m.PlanModifyString(context.Background(), planmodifier.StringRequest{
+					Path: path.Root("kind"), State: state, Plan: plan, Config: config,
+					StateValue: stateModel.Kind, PlanValue: planModel.Kind, ConfigValue: planModel.Kind,
+				}, kindResp)
+			}
+			assert.Equal(t, tt.wantKind, kindResp.RequiresReplace, "kind")
+
+			pkResp := &planmodifier.ObjectResponse{PlanValue: planModel.PrimaryKey}
+			for _, m := range schema.Attributes["primary_key"].(rschema.SingleNestedAttribute).PlanModifiers {
+				m.PlanModifyObject(context.Background(), planmodifier.ObjectRequest{
+					Path: path.Root("primary_key"), State: state, Plan: plan, Config: config,
+					StateValue: stateModel.PrimaryKey, PlanValue: planModel.PrimaryKey, ConfigValue: planModel.PrimaryKey,
+				}, pkResp)
+			}
+			assert.Equal(t, tt.wantPK, pkResp.RequiresReplace, "primary_key")
+		})
+	}
+}
+
+func TestCollectionResource_ValidateConfig(t *testing.T) {
+	r := &CollectionResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *CollectionResourceModel)
+		expectErr bool
+	}{
+		{"minimal", func(m *CollectionResourceModel) {}, false},
+		{"archiving", func(m *CollectionResourceModel) {
+			m.Archive = types.StringValue("status")
+			m.ArchiveValue = types.StringValue("archived")
+			m.UnarchiveValue = types.StringValue("draft")
+			m.ArchiveAppFilter = types.BoolValue(false)
+		}, false},
+		{"archive field without values", func(m *CollectionResourceModel) { m.Archive = types.StringValue("status") }, true},
+		{"archive field without unarchive value", func(m *CollectionResourceModel) {
+			m.Archive = types.StringValue("status")
+			m.ArchiveValue = types.StringValue("archived")
+		}, true},
+		{"archive value without field", func(m *CollectionResourceModel) { m.ArchiveValue = types.StringValue("archived") }, true},
+		{"archive app filter without field", func(m *CollectionResourceModel) { m.ArchiveAppFilter = types.BoolValue(true) }, true},
+		{"accountability activity", func(m *CollectionResourceModel) { m.Accountability = types.StringValue("activity") }, false},
+		{"accountability none", func(m *CollectionResourceModel) { m.Accountability = types.StringValue("none") }, false},
+		{"invalid accountability", func(m *CollectionResourceModel) { m.Accountability = types.StringValue("revisions") }, true},
+		{"collapse locked", func(m *CollectionResourceModel) { m.Collapse = types.StringValue("locked") }, false},
+		{"invalid collapse", func(m *CollectionResourceModel) { m.Collapse = types.StringValue("hidden") }, true},
+		{"own group", func(m *CollectionResourceModel) { m.Group = types.StringValue("articles") }, true},
+		{"folder", func(m *CollectionResourceModel) { m.Kind = types.StringValue("folder") }, false},
+		{"invalid kind", func(m *CollectionResourceModel) { m.Kind = types.StringValue("view") }, true},
+		{"folder with primary key", func(m *CollectionResourceModel) {
+			m.Kind = types.StringValue("folder")
+			m.PrimaryKey = collectionPrimaryKeyValue(collectionPrimaryKeyModel{Name: types.StringNull(), Type: types.StringValue("uuid"), AutoIncrement: types.BoolNull()})
+		}, true},
+		{"folder with sort field", func(m *CollectionResourceModel) {
+			m.Kind = types.StringValue("folder")
+			m.SortField = types.StringValue("sort")
+		}, true},
+		{"uuid primary key", func(m *CollectionResourceModel) {
+			m.PrimaryKey = collectionPrimaryKeyValue(collectionPrimaryKeyModel{Name: types.StringNull(), Type: types.StringValue("uuid"), AutoIncrement: types.BoolNull()})
+		}, false},
+		{"invalid primary key type", func(m *CollectionResourceModel) {
+			m.PrimaryKey = collectionPrimaryKeyValue(collectionPrimaryKeyModel{Name: types.StringNull(), Type: types.StringValue("float"), AutoIncrement: types.BoolNull()})
+		}, true},
+		{"auto-incremented string key", func(m *CollectionResourceModel) {
+			m.PrimaryKey = collectionPrimaryKeyValue(collectionPrimaryKeyModel{Name: types.StringValue("slug"), Type: types.StringValue("string"), AutoIncrement: types.BoolValue(true)})
+		}, true},
+		{"translations", func(m *CollectionResourceModel) {
+			m.Translations = types.StringValue(`[{"language": "de-DE", "translation": "Artikel", "singular": "Artikel", "plural": "Artikel"}]`)
+		}, false},
+		{"translations not an array", func(m *CollectionResourceModel) {
+			m.Translations = types.StringValue(`{"language": "de-DE", "translation": "Artikel"}`)
+		}, true},
+		{"translation without language", func(m *CollectionResourceModel) {
+			m.Translations = types.StringValue(`[{"translation": "Artikel"}]`)
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newCollectionModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // buildCollectionInput
 // ---------------------------------------------------------------------------
@@ -85,7 +269,159 @@ func TestBuildCollectionCreateInput(t *testing.T) {
 	})
 }
 
+func TestBuildCollectionInput_Kind(t *testing.T) {
+	t.Run("folder has no schema", func(t *testing.T) {
+		model := newCollectionModel()
+		model.Kind = types.StringValue("folder")
+
+		input := buildCollectionInput(*model, true)
+
+		assert.Contains(t, input, "schema")
+		assert.Nil(t, input["schema"])
+		assert.NotContains(t, input, "fields")
+	})
+
+	t.Run("table with uuid primary key", func(t *testing.T) {
+		model := newCollectionModel()
+		model.Kind = types.StringValue("table")
+		model.PrimaryKey = collectionPrimaryKeyValue(collectionPrimaryKeyModel{
+			Name: types.StringValue("uuid"), Type: types.StringValue("uuid"), AutoIncrement: types.BoolValue(false),
+		})
+
+		input := buildCollectionInput(*model, true)
+
+		assert.Equal(t, map[string]interface{}{}, input["schema"])
+		fields := input["fields"].([]interface{})
+		require.Len(t, fields, 1)
+		field := fields[0].(map[string]interface{})
+		assert.Equal(t, "uuid", field["field"])
+		assert.Equal(t, "uuid", field["type"])
+		assert.Equal(t, []string{"uuid"}, field["meta"].(map[string]interface{})["special"])
+		assert.Equal(t, true, field["schema"].(map[string]interface{})["is_primary_key"])
+	})
+
+	t.Run("update never sends schema or fields", func(t *testing.T) {
+		model := newCollectionModel()
+		model.PrimaryKey = resolveCollectionPrimaryKey(*model)
+
+		input := buildCollectionInput(*model, false)
+
+		assert.NotContains(t, input, "schema")
+		assert.NotContains(t, input, "fields")
+	})
+}
+
+func TestResolveCollectionPrimaryKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		kind          string
+		primaryKey    types.Object
+		wantNull      bool
+		wantName      string
+		wantType      string
+		wantIncrement bool
+	}{
+		{"default", "table", types.ObjectUnknown(collectionPrimaryKeyAttrTypes), false, "id", "integer", true},
+		{"uuid", "table", collectionPrimaryKeyValue(collectionPrimaryKeyModel{
+			Name: types.StringValue("id"), Type: types.StringValue("uuid"), AutoIncrement: types.BoolUnknown(),
+		}), false, "id", "uuid", false},
+		{"manual integer", "table", collectionPrimaryKeyValue(collectionPrimaryKeyModel{
+			Name: types.StringValue("code"), Type: types.StringValue("integer"), AutoIncrement: types.BoolValue(false),
+		}), false, "code", "integer", false},
+		{"folder", "folder", types.ObjectUnknown(collectionPrimaryKeyAttrTypes), true, "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newCollectionModel()
+			model.Kind = types.StringValue(tt.kind)
+			model.PrimaryKey = tt.primaryKey
+
+			resolved := resolveCollectionPrimaryKey(*model)
+			if tt.wantNull {
+				assert.True(t, resolved.IsNull())
+				return
+			}
+
+			pk := collectionPrimaryKeyFromObject(resolved)
+			require.NotNil(t, pk)
+			assert.Equal(t, tt.wantName, pk.Name.ValueString())
+			assert.Equal(t, tt.wantType, pk.Type.ValueString())
+			assert.Equal(t, tt.wantIncrement, pk.AutoIncrement.ValueBool())
+		})
+	}
+}
+
+func TestCollectionPrimaryKeyFromFields(t *testing.T) {
+	var fields []collectionFieldResponse
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"field": "title", "type": "string", "schema": {"is_primary_key": false}},
+		{"field": "divider", "type": "alias", "schema": null},
+		{"field": "slug", "type": "string", "schema": {"is_primary_key": true, "has_auto_increment": false}}
+	]`), &fields))
+
+	pk := collectionPrimaryKeyFromObject(collectionPrimaryKeyFromFields(fields))
+	require.NotNil(t, pk)
+	assert.Equal(t, "slug", pk.Name.ValueString())
+	assert.Equal(t, "string", pk.Type.ValueString())
+	assert.False(t, pk.AutoIncrement.ValueBool())
+
+	assert.True(t, collectionPrimaryKeyFromFields(nil).IsNull())
+}
+
+func TestBuildCollectionInput_FullMeta(t *testing.T) {
+	model := newCollectionModel()
+	model.DisplayTemplate = types.StringValue("{{title}}")
+	model.Translations = types.StringValue(`[{"language": "de-DE", "translation": "Artikel"}]`)
+	model.Archive = types.StringValue("status")
+	model.ArchiveAppFilter = types.BoolValue(false)
+	model.ArchiveValue = types.StringValue("archived")
+	model.UnarchiveValue = types.StringValue("draft")
+	model.Accountability = types.StringValue("activity")
+	model.ItemDuplicationFields = stringListOrNull([]string{"title", "body"})
+	model.Sort = types.Int64Value(3)
+	model.Group = types.StringValue("content")
+	model.Collapse = types.StringValue("closed")
+	model.PreviewURL = types.StringValue("https://example.com/preview/{{id}}")
+	model.Versioning = types.BoolValue(true)
+
+	meta := buildCollectionInput(*model, true)["meta"].(map[string]interface{})
+
+	assert.Equal(t, "{{title}}", meta["display_template"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"language": "de-DE", "translation": "Artikel"}}, meta["translations"])
+	assert.Equal(t, false, meta["archive_app_filter"])
+	assert.Equal(t, "archived", meta["archive_value"])
+	assert.Equal(t, "draft", meta["unarchive_value"])
+	assert.Equal(t, "activity", meta["accountability"])
+	assert.Equal(t, []string{"title", "body"}, meta["item_duplication_fields"])
+	assert.Equal(t, int64(3), meta["sort"])
+	assert.Equal(t, "content", meta["group"])
+	assert.Equal(t, "closed", meta["collapse"])
+	assert.Equal(t, "https://example.com/preview/{{id}}", meta["preview_url"])
+	assert.Equal(t, true, meta["versioning"])
+}
+
 func TestBuildCollectionUpdateInput(t *testing.T) {
+	t.Run("removed fields are cleared", func(t *testing.T) {
+		meta := buildCollectionInput(*newCollectionModel(), false)["meta"].(map[string]interface{})
+
+		for _, key := range []string{"icon", "note", "display_template", "translations", "archive_field", "archive_value", "item_duplication_fields", "group", "preview_url"} {
+			assert.Contains(t, meta, key)
+			assert.Nil(t, meta[key], key)
+		}
+		assert.NotContains(t, meta, "accountability", "computed fields are left unchanged")
+		assert.NotContains(t, meta, "collapse", "computed fields are left unchanged")
+	})
+
+	t.Run("accountability none disables tracking", func(t *testing.T) {
+		model := newCollectionModel()
+		model.Accountability = types.StringValue("none")
+
+		body, err := json.Marshal(buildCollectionInput(*model, false))
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `"accountability":null`)
+	})
+
 	t.Run("partial update", func(t *testing.T) {
 		input := buildCollectionInput(CollectionResourceModel{
 			Collection: types.StringValue("test_collection"),
@@ -119,7 +455,7 @@ func TestBuildCollectionUpdateInput(t *testing.T) {
 
 func TestCollectionAPIResponseToModel(t *testing.T) {
 	t.Run("minimal response (no meta)", func(t *testing.T) {
-		model := (&collectionAPIResponse{Collection: "test_collection"}).toModel()
+		model := (&collectionAPIResponse{Collection: "test_collection"}).toModel(CollectionResourceModel{})
 
 		assert.Equal(t, "test_collection", model.Collection.ValueString())
 		assert.True(t, model.Icon.IsNull())
@@ -136,7 +472,7 @@ func TestCollectionAPIResponseToModel(t *testing.T) {
 				Singleton: false, SortField: "sort_order",
 				ArchiveField: "status", Color: "#6644FF",
 			},
-		}).toModel()
+		}).toModel(CollectionResourceModel{})
 
 		assert.Equal(t, "test_collection", model.Collection.ValueString())
 		assert.Equal(t, "list", model.Icon.ValueString())
@@ -148,11 +484,71 @@ func TestCollectionAPIResponseToModel(t *testing.T) {
 		assert.Equal(t, "#6644FF", model.Color.ValueString())
 	})
 
+	t.Run("accountability null is none", func(t *testing.T) {
+		model := (&collectionAPIResponse{
+			Collection: "articles",
+			Meta:       &collectionMetaResponse{Accountability: ""},
+		}).toModel(CollectionResourceModel{})
+		assert.Equal(t, "none", model.Accountability.ValueString())
+	})
+
+	t.Run("extended meta", func(t *testing.T) {
+		archiveAppFilter := false
+		sort := int64(2)
+		prior := CollectionResourceModel{
+			Translations: types.StringValue(`[{"translation": "Artikel", "language": "de-DE"}]`),
+		}
+
+		model := (&collectionAPIResponse{
+			Collection: "articles",
+			Meta: &collectionMetaResponse{
+				DisplayTemplate: "{{title}}", Translations: []byte(`[{"language":"de-DE","translation":"Artikel"}]`),
+				ArchiveField: "status", ArchiveAppFilter: &archiveAppFilter, ArchiveValue: "archived", UnarchiveValue: "draft",
+				Accountability: "activity", ItemDuplicationFields: []string{"title"}, Sort: &sort,
+				Group: "content", Collapse: "locked", PreviewURL: "https://example.com/{{id}}", Versioning: true,
+			},
+		}).toModel(prior)
+
+		assert.Equal(t, "{{title}}", model.DisplayTemplate.ValueString())
+		assert.Equal(t, prior.Translations.ValueString(), model.Translations.ValueString(), "equal JSON keeps the configured value")
+		assert.False(t, model.ArchiveAppFilter.ValueBool())
+		assert.Equal(t, "archived", model.ArchiveValue.ValueString())
+		assert.Equal(t, "draft", model.UnarchiveValue.ValueString())
+		assert.Equal(t, "activity", model.Accountability.ValueString())
+		assert.Len(t, model.ItemDuplicationFields.Elements(), 1)
+		assert.Equal(t, int64(2), model.Sort.ValueInt64())
+		assert.Equal(t, "content", model.Group.ValueString())
+		assert.Equal(t, "locked", model.Collapse.ValueString())
+		assert.Equal(t, "https://example.com/{{id}}", model.PreviewURL.ValueString())
+		assert.True(t, model.Versioning.ValueBool())
+	})
+
+	t.Run("empty translations are null", func(t *testing.T) {
+		model := (&collectionAPIResponse{
+			Collection: "articles",
+			Meta:       &collectionMetaResponse{Translations: []byte(`[]`)},
+		}).toModel(CollectionResourceModel{})
+
+		assert.True(t, model.Translations.IsNull())
+		assert.True(t, model.Sort.IsNull())
+		assert.True(t, model.ArchiveAppFilter.ValueBool())
+		assert.Equal(t, "open", model.Collapse.ValueString())
+	})
+
+	t.Run("kind", func(t *testing.T) {
+		folder := (&collectionAPIResponse{Collection: "content"}).toModel(CollectionResourceModel{})
+		assert.Equal(t, "folder", folder.Kind.ValueString())
+		assert.True(t, folder.PrimaryKey.IsNull())
+
+		table := (&collectionAPIResponse{Collection: "articles", Schema: &collectionSchemaResponse{Name: "articles"}}).toModel(CollectionResourceModel{})
+		assert.Equal(t, "table", table.Kind.ValueString())
+	})
+
 	t.Run("response with partial meta", func(t *testing.T) {
 		model := (&collectionAPIResponse{
 			Collection: "test_collection",
 			Meta:       &collectionMetaResponse{Icon: "list", Hidden: false},
-		}).toModel()
+		}).toModel(CollectionResourceModel{})
 
 		assert.Equal(t, "list", model.Icon.ValueString())
 		assert.True(t, model.Note.IsNull())
@@ -380,3 +776,155 @@ func TestCollectionResource_Delete(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestCollectionResource_Read_ImportLooksUpPrimaryKey(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/collections/articles":
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{
+					"collection": "articles",
+					"schema":     map[string]interface{}{"name": "articles"},
+				},
+			}), nil
+		case "/fields/articles":
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []interface{}{
+					map[string]interface{}{"field": "id", "type": "uuid", "schema": map[string]interface{}{"is_primary_key": true}},
+				},
+			}), nil
+		}
+		t.Fatalf("unexpected request %s", req.URL)
+		return nil, nil
+	})
+
+	r := &CollectionResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, newCollectionModel())
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result CollectionResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "table", result.Kind.ValueString())
+	pk := collectionPrimaryKeyFromObject(result.PrimaryKey)
+	require.NotNil(t, pk)
+	assert.Equal(t, "uuid", pk.Type.ValueString())
+	assert.False(t, pk.AutoIncrement.ValueBool())
+}
+
+// ---------------------------------------------------------------------------
+// Data-loss safeguards
+// ---------------------------------------------------------------------------
+
+// newStoredCollectionModel returns a collection model as it is stored in state.
+func newStoredCollectionModel(collection string) *CollectionResourceModel {
+	model := newCollectionModel()
+	model.Collection = types.StringValue(collection)
+	model.Kind = types.StringValue("table")
+	model.PrimaryKey = resolveCollectionPrimaryKey(*model)
+	model.ForceDestroy = types.BoolValue(false)
+	return model
+}
+
+// mockCollectionCount answers aggregate count queries with count and records DELETE requests.
+func mockCollectionCount(t *testing.T, count int, deleted *bool) *client.Client {
+	return newMockClient(func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case "GET":
+			assert.Equal(t, "*", req.URL.Query().Get("aggregate[count]"))
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []interface{}{map[string]interface{}{"count": fmt.Sprint(count)}},
+			}), nil
+		case "DELETE":
+			*deleted = true
+			return mockJSONResponse(204, nil), nil
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+}
+
+func TestCollectionResource_Delete_Safeguard(t *testing.T) {
+	tests := []struct {
+		name        string
+		kind        string
+		count       int
+		force       bool
+		wantErr     bool
+		wantDeleted bool
+	}{
+		{"empty collection", "table", 0, false, false, true},
+		{"collection with items", "table", 3, false, true, false},
+		{"forced", "table", 3, true, false, true},
+		{"folder", "folder", 0, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted bool
+			r := &CollectionResource{client: mockCollectionCount(t, tt.count, &deleted)}
+			schema := getResourceSchema(t, r)
+
+			model := newStoredCollectionModel("articles")
+			model.Kind = types.StringValue(tt.kind)
+			model.ForceDestroy = types.BoolValue(tt.force)
+			state := makeState(t, schema, model)
+
+			resp := &fwresource.DeleteResponse{State: state}
+			r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestCollectionResource_ModifyPlan_ReplacementWarning(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(plan *CollectionResourceModel)
+		count       int
+		wantWarning string
+	}{
+		{"in-place update", func(plan *CollectionResourceModel) { plan.Note = types.StringValue("changed") }, 5, ""},
+		{"rename with items", func(plan *CollectionResourceModel) { plan.Collection = types.StringValue("posts") }, 5, "5 item(s)"},
+		{"rename without items", func(plan *CollectionResourceModel) { plan.Collection = types.StringValue("posts") }, 0, ""},
+		{"primary key change", func(plan *CollectionResourceModel) {
+			plan.PrimaryKey = collectionPrimaryKeyValue(collectionPrimaryKeyModel{
+				Name: types.StringValue("id"), Type: types.StringValue("uuid"), AutoIncrement: types.BoolValue(false),
+			})
+		}, 2, "2 item(s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted bool
+			r := &CollectionResource{client: mockCollectionCount(t, tt.count, &deleted)}
+			schema := getResourceSchema(t, r)
+
+			planModel := newStoredCollectionModel("articles")
+			tt.modify(planModel)
+			plan := makePlan(t, schema, planModel)
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+				Plan:  plan,
+				State: makeState(t, schema, newStoredCollectionModel("articles")),
+			}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "ModifyPlan diagnostics: %v", resp.Diagnostics)
+			if tt.wantWarning == "" {
+				assert.Empty(t, resp.Diagnostics.Warnings())
+				return
+			}
+			require.Len(t, resp.Diagnostics.Warnings(), 1)
+			assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), tt.wantWarning)
+			assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "force_destroy")
+			assert.False(t, deleted)
+		})
+	}
+}
@@ -0,0 +1,462 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &CollectionsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &CollectionsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &CollectionsDataSource{}
+)
+
+// NewCollectionsDataSource creates a new collections data source.
+func NewCollectionsDataSource() datasource.DataSource {
+	return &CollectionsDataSource{}
+}
+
+// CollectionsDataSource defines the data source implementation.
+type CollectionsDataSource struct {
+	client *client.Client
+}
+
+// CollectionsDataSourceModel describes the data source data model.
+type CollectionsDataSourceModel struct {
+	Filter         types.String                `tfsdk:"filter"`
+	Sort           types.List                  `tfsdk:"sort"`
+	Limit          types.Int64                 `tfsdk:"limit"`
+	ExcludeSystem  types.Bool                  `tfsdk:"exclude_system"`
+	ExcludeFolders types.Bool                  `tfsdk:"exclude_folders"`
+	Collections    []CollectionDataSourceModel `tfsdk:"collections"`
+}
+
+// CollectionDataSourceModel describes a collection returned by the data source.
+type CollectionDataSourceModel struct {
+	Collection            types.String `tfsdk:"collection"`
+	Kind                  types.String `tfsdk:"kind"`
+	Icon                  types.String `tfsdk:"icon"`
+	Note                  types.String `tfsdk:"note"`
+	DisplayTemplate       types.String `tfsdk:"display_template"`
+	Hidden                types.Bool   `tfsdk:"hidden"`
+	Singleton             types.Bool   `tfsdk:"singleton"`
+	Translations          types.String `tfsdk:"translations"`
+	SortField             types.String `tfsdk:"sort_field"`
+	Archive               types.String `tfsdk:"archive_field"`
+	ArchiveAppFilter      types.Bool   `tfsdk:"archive_app_filter"`
+	ArchiveValue          types.String `tfsdk:"archive_value"`
+	UnarchiveValue        types.String `tfsdk:"unarchive_value"`
+	Accountability        types.String `tfsdk:"accountability"`
+	Color                 types.String `tfsdk:"color"`
+	ItemDuplicationFields types.List   `tfsdk:"item_duplication_fields"`
+	Sort                  types.Int64  `tfsdk:"sort"`
+	Group                 types.String `tfsdk:"group"`
+	Collapse              types.String `tfsdk:"collapse"`
+	PreviewURL            types.String `tfsdk:"preview_url"`
+	Versioning            types.Bool   `tfsdk:"versioning"`
+}
+
+func (d *CollectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+func (d *CollectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Directus collections. The `/collections` endpoint does not accept query parameters, " +
+			"so `filter`, `sort` and `limit` are applied by the provider to the collection objects as Directus returns them, " +
+			"e.g. `{ meta = { hidden = { _eq = false } } }`.",
+
+		Attributes: listQueryAttributes("collections"),
+	}
+
+	resp.Schema.Attributes["exclude_system"] = schema.BoolAttribute{
+		MarkdownDescription: "Leave out the `directus_*` system collections.",
+		Optional:            true,
+	}
+	resp.Schema.Attributes["exclude_folders"] = schema.BoolAttribute{
+		MarkdownDescription: "Leave out folders, the collections without a database table that only group other collections.",
+		Optional:            true,
+	}
+	resp.Schema.Attributes["collections"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching collections.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"collection":              schema.StringAttribute{Computed: true, MarkdownDescription: "The name of the collection."},
+				"kind":                    schema.StringAttribute{Computed: true, MarkdownDescription: "`table` for collections backed by a database table, `folder` for folders."},
+				"icon":                    schema.StringAttribute{Computed: true, MarkdownDescription: "The name of the Google Material Design Icon of the collection."},
+				"note":                    schema.StringAttribute{Computed: true, MarkdownDescription: "The note shown in the Data Studio."},
+				"display_template":        schema.StringAttribute{Computed: true, MarkdownDescription: "The template used to display items of the collection."},
+				"hidden":                  schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the collection is hidden in the Data Studio."},
+				"singleton":               schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the collection holds a single item."},
+				"translations":            schema.StringAttribute{Computed: true, MarkdownDescription: "The collection name translations as a JSON array."},
+				"sort_field":              schema.StringAttribute{Computed: true, MarkdownDescription: "The field used for manual sorting."},
+				"archive_field":           schema.StringAttribute{Computed: true, MarkdownDescription: "The field used to archive items."},
+				"archive_app_filter":      schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether archived items are hidden in the Data Studio by default."},
+				"archive_value":           schema.StringAttribute{Computed: true, MarkdownDescription: "The value that marks an item as archived."},
+				"unarchive_value":         schema.StringAttribute{Computed: true, MarkdownDescription: "The value that marks an item as not archived."},
+				"accountability":          schema.StringAttribute{Computed: true, MarkdownDescription: "What is tracked for the collection: `all`, `activity`, or `none`."},
+				"color":                   schema.StringAttribute{Computed: true, MarkdownDescription: "The color of the collection icon."},
+				"item_duplication_fields": schema.ListAttribute{Computed: true, ElementType: types.StringType, MarkdownDescription: "The fields copied when an item is duplicated."},
+				"sort":                    schema.Int64Attribute{Computed: true, MarkdownDescription: "The position of the collection in the navigation."},
+				"group":                   schema.StringAttribute{Computed: true, MarkdownDescription: "The parent collection or folder in the navigation."},
+				"collapse":                schema.StringAttribute{Computed: true, MarkdownDescription: "How the collection group is shown in the navigation: `open`, `closed`, or `locked`."},
+				"preview_url":             schema.StringAttribute{Computed: true, MarkdownDescription: "The URL template for live previews."},
+				"versioning":              schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether content versioning is enabled."},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the filter expression and limit.
+func (d *CollectionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data CollectionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateJSONObject(data.Filter); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+	} else if !data.Filter.IsNull() && !data.Filter.IsUnknown() {
+		var filter map[string]interface{}
+		_ = json.Unmarshal([]byte(data.Filter.ValueString()), &filter)
+		if _, err := matchesFilter(map[string]interface{}{}, filter); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+		}
+	}
+	if err := validatePositiveInt64(data.Limit); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid Limit", err.Error())
+	}
+}
+
+func (d *CollectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := d.client.List(ctx, "collections", &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Collections",
+			fmt.Sprintf("Could not list collections: %s", err.Error()),
+		)
+		return
+	}
+
+	var filter map[string]interface{}
+	if !data.Filter.IsNull() {
+		if err := json.Unmarshal([]byte(data.Filter.ValueString()), &filter); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+			return
+		}
+	}
+
+	// Each collection is decoded twice: generically for filtering and sorting, and into
+	// collectionAPIResponse for the returned attributes.
+	type listedCollection struct {
+		raw      map[string]interface{}
+		response collectionAPIResponse
+	}
+	var collections []listedCollection
+	for _, raw := range result.Data {
+		var c listedCollection
+		if err := json.Unmarshal(raw, &c.raw); err != nil {
+			resp.Diagnostics.AddError("Error Listing Collections", fmt.Sprintf("Could not decode collection: %s", err.Error()))
+			return
+		}
+		if err := json.Unmarshal(raw, &c.response); err != nil {
+			resp.Diagnostics.AddError("Error Listing Collections", fmt.Sprintf("Could not decode collection: %s", err.Error()))
+			return
+		}
+
+		if data.ExcludeSystem.ValueBool() && strings.HasPrefix(c.response.Collection, "directus_") {
+			continue
+		}
+		if data.ExcludeFolders.ValueBool() && c.response.Schema == nil {
+			continue
+		}
+		if filter != nil {
+			matched, err := matchesFilter(c.raw, filter)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+				return
+			}
+			if !matched {
+				continue
+			}
+		}
+		collections = append(collections, c)
+	}
+
+	if fields := stringListValues(data.Sort); len(fields) > 0 {
+		sort.SliceStable(collections, func(i, j int) bool {
+			return compareBySortFields(collections[i].raw, collections[j].raw, fields) < 0
+		})
+	}
+	if !data.Limit.IsNull() && int64(len(collections)) > data.Limit.ValueInt64() {
+		collections = collections[:data.Limit.ValueInt64()]
+	}
+
+	data.Collections = make([]CollectionDataSourceModel, len(collections))
+	for i, c := range collections {
+		model := c.response.toModel(CollectionResourceModel{})
+		data.Collections[i] = CollectionDataSourceModel{
+			Collection:            model.Collection,
+			Kind:                  model.Kind,
+			Icon:                  model.Icon,
+			Note:                  model.Note,
+			DisplayTemplate:       model.DisplayTemplate,
+			Hidden:                model.Hidden,
+			Singleton:             model.Singleton,
+			Translations:          model.Translations,
+			SortField:             model.SortField,
+			Archive:               model.Archive,
+			ArchiveAppFilter:      model.ArchiveAppFilter,
+			ArchiveValue:          model.ArchiveValue,
+			UnarchiveValue:        model.UnarchiveValue,
+			Accountability:        model.Accountability,
+			Color:                 model.Color,
+			ItemDuplicationFields: model.ItemDuplicationFields,
+			Sort:                  model.Sort,
+			Group:                 model.Group,
+			Collapse:              model.Collapse,
+			PreviewURL:            model.PreviewURL,
+			Versioning:            model.Versioning,
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matchesFilter evaluates a Directus filter expression against a decoded JSON value. Keys
+// starting with an underscore are logical operators (_and, _or) or comparison operators;
+// any other key descends into the field of that name, so nested objects such as meta are
+// filtered the same way Directus filters relations. Every condition is evaluated so that
+// an unsupported operator is reported regardless of the data.
+func matchesFilter(value interface{}, filter map[string]interface{}) (bool, error) {
+	all := true
+	for key, condition := range filter {
+		var matched bool
+		var err error
+
+		switch {
+		case key == "_and" || key == "_or":
+			matched, err = matchesLogicalFilter(value, key, condition)
+		case strings.HasPrefix(key, "_"):
+			matched, err = matchesFilterOperator(value, key, condition)
+		default:
+			nested, ok := condition.(map[string]interface{})
+			if !ok {
+				return false, fmt.Errorf("the condition for field %q must be an object", key)
+			}
+			object, _ := value.(map[string]interface{})
+			matched, err = matchesFilter(object[key], nested)
+		}
+
+		if err != nil {
+			return false, err
+		}
+		all = all && matched
+	}
+	return all, nil
+}
+
+// matchesLogicalFilter evaluates the list of filters of an _and or _or operator.
+func matchesLogicalFilter(value interface{}, operator string, condition interface{}) (bool, error) {
+	filters, ok := condition.([]interface{})
+	if !ok {
+		return false, fmt.Errorf("%s must be a list of filters", operator)
+	}
+
+	anyMatched, allMatched := false, true
+	for _, f := range filters {
+		nested, ok := f.(map[string]interface{})
+		if !ok {
+			return false, fmt.Errorf("%s must be a list of filters", operator)
+		}
+		matched, err := matchesFilter(value, nested)
+		if err != nil {
+			return false, err
+		}
+		anyMatched = anyMatched || matched
+		allMatched = allMatched && matched
+	}
+	if operator == "_or" {
+		return anyMatched, nil
+	}
+	return allMatched, nil
+}
+
+// matchesFilterOperator applies a single comparison operator to a value.
+func matchesFilterOperator(value interface{}, operator string, operand interface{}) (bool, error) {
+	text := func(v interface{}) string {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprint(v)
+	}
+
+	switch operator {
+	case "_eq":
+		return filterValuesEqual(value, operand), nil
+	case "_neq":
+		return !filterValuesEqual(value, operand), nil
+	case "_in", "_nin":
+		operands, ok := operand.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("%s requires a list", operator)
+		}
+		found := false
+		for _, o := range operands {
+			found = found || filterValuesEqual(value, o)
+		}
+		return found == (operator == "_in"), nil
+	case "_null":
+		return (value == nil) == truthy(operand), nil
+	case "_nnull":
+		return (value != nil) == truthy(operand), nil
+	case "_empty", "_nempty":
+		empty := value == nil || text(value) == ""
+		if list, ok := value.([]interface{}); ok {
+			empty = len(list) == 0
+		}
+		return empty == ((operator == "_empty") == truthy(operand)), nil
+	case "_contains":
+		return value != nil && strings.Contains(text(value), text(operand)), nil
+	case "_ncontains":
+		return value == nil || !strings.Contains(text(value), text(operand)), nil
+	case "_icontains":
+		return value != nil && strings.Contains(strings.ToLower(text(value)), strings.ToLower(text(operand))), nil
+	case "_starts_with":
+		return value != nil && strings.HasPrefix(text(value), text(operand)), nil
+	case "_nstarts_with":
+		return value == nil || !strings.HasPrefix(text(value), text(operand)), nil
+	case "_ends_with":
+		return value != nil && strings.HasSuffix(text(value), text(operand)), nil
+	case "_nends_with":
+		return value == nil || !strings.HasSuffix(text(value), text(operand)), nil
+	case "_gt":
+		return value != nil && compareFilterValues(value, operand) > 0, nil
+	case "_gte":
+		return value != nil && compareFilterValues(value, operand) >= 0, nil
+	case "_lt":
+		return value != nil && compareFilterValues(value, operand) < 0, nil
+	case "_lte":
+		return value != nil && compareFilterValues(value, operand) <= 0, nil
+	}
+	return false, fmt.Errorf("unsupported filter operator %q", operator)
+}
+
+// truthy reports whether a filter operand such as the true in {"_null": true} is set.
+func truthy(operand interface{}) bool {
+	switch v := operand.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true" || v == "1"
+	case float64:
+		return v != 0
+	}
+	return operand != nil
+}
+
+// filterValuesEqual compares values the way query string filters do: numbers by value and
+// everything else by its string form, so true matches "true".
+func filterValuesEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return compareFilterValues(a, b) == 0
+}
+
+// compareFilterValues orders two values numerically when both are numbers and by their
+// string form otherwise. nil sorts before any other value.
+func compareFilterValues(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	x, xErr := strconv.ParseFloat(fmt.Sprint(a), 64)
+	y, yErr := strconv.ParseFloat(fmt.Sprint(b), 64)
+	if xErr == nil && yErr == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// compareBySortFields compares two decoded objects by a list of sort fields. Fields use
+// dot notation for nested values (meta.sort) and a leading - for descending order.
+func compareBySortFields(a, b map[string]interface{}, fields []string) int {
+	for _, field := range fields {
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+
+		result := compareFilterValues(lookupFieldPath(a, field), lookupFieldPath(b, field))
+		if descending {
+			result = -result
+		}
+		if result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+// lookupFieldPath returns the value at a dotted field path, or nil if it does not exist.
+func lookupFieldPath(object map[string]interface{}, field string) interface{} {
+	var value interface{} = object
+	for _, part := range strings.Split(field, ".") {
+		current, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = current[part]
+	}
+	return value
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_collection" "group" {
+  collection = "acc_test_list_group"
+  kind       = "folder"
+}
+
+resource "directus_collection" "first" {
+  collection = "acc_test_list_first"
+  group      = directus_collection.group.collection
+  sort       = 2
+}
+
+resource "directus_collection" "second" {
+  collection = "acc_test_list_second"
+  group      = directus_collection.group.collection
+  sort       = 1
+}
+
+data "directus_collections" "test" {
+  filter          = jsonencode({ collection = { _starts_with = "acc_test_list_" } })
+  sort            = ["meta.sort"]
+  exclude_system  = true
+  exclude_folders = true
+
+  depends_on = [directus_collection.first, directus_collection.second]
+}
+
+data "directus_collections" "with_folders" {
+  filter = jsonencode({ collection = { _starts_with = "acc_test_list_" } })
+
+  depends_on = [directus_collection.first, directus_collection.second]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.directus_collections.test", "collections.#", "2"),
+					resource.TestCheckResourceAttr("data.directus_collections.test", "collections.0.collection", "acc_test_list_second"),
+					resource.TestCheckResourceAttr("data.directus_collections.test", "collections.0.group", "acc_test_list_group"),
+					resource.TestCheckResourceAttr("data.directus_collections.test", "collections.1.collection", "acc_test_list_first"),
+					resource.TestCheckResourceAttr("data.directus_collections.with_folders", "collections.#", "3"),
+				),
+			},
+		},
+	})
+}
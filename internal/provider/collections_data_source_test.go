@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCollectionsQueryModel returns a data source config model without exclusions.
+func newCollectionsQueryModel(filter string, sort []string, limit types.Int64) *CollectionsDataSourceModel {
+	return &CollectionsDataSourceModel{
+		Filter:         stringOrNull(filter),
+		Sort:           stringListOrNull(sort),
+		Limit:          limit,
+		ExcludeSystem:  types.BoolNull(),
+		ExcludeFolders: types.BoolNull(),
+	}
+}
+
+// readCollectionsDataSource runs Read against a fixed set of collections and returns the
+// names of the collections in the resulting state.
+func readCollectionsDataSource(t *testing.T, model *CollectionsDataSourceModel) (*fwdatasource.ReadResponse, []string) {
+	t.Helper()
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/collections", req.URL.Path)
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []interface{}{
+				map[string]interface{}{
+					"collection": "articles",
+					"meta":       map[string]interface{}{"icon": "article", "sort": 2, "group": "content"},
+					"schema":     map[string]interface{}{"name": "articles"},
+				},
+				map[string]interface{}{
+					"collection": "content",
+					"meta":       map[string]interface{}{"sort": 1},
+					"schema":     nil,
+				},
+				map[string]interface{}{
+					"collection": "authors",
+					"meta":       map[string]interface{}{"hidden": true, "sort": 3, "group": "content"},
+					"schema":     map[string]interface{}{"name": "authors"},
+				},
+				map[string]interface{}{
+					"collection": "directus_users",
+					"meta":       map[string]interface{}{"system": true},
+					"schema":     map[string]interface{}{"name": "directus_users"},
+				},
+			},
+		}), nil
+	})
+
+	d := &CollectionsDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, model)}, resp)
+	if resp.Diagnostics.HasError() {
+		return resp, nil
+	}
+
+	var result CollectionsDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	names := make([]string, len(result.Collections))
+	for i, c := range result.Collections {
+		names[i] = c.Collection.ValueString()
+	}
+	return resp, names
+}
+
+func TestCollectionsDataSource_Metadata(t *testing.T) {
+	d := &CollectionsDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_collections", resp.TypeName)
+}
+
+func TestCollectionsDataSource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    string
+		limit     types.Int64
+		expectErr bool
+	}{
+		{"no query", "", types.Int64Null(), false},
+		{"supported filter", `{"_or": [{"meta": {"hidden": {"_eq": false}}}, {"collection": {"_in": ["a"]}}]}`, types.Int64Value(1), false},
+		{"invalid json", `{`, types.Int64Null(), true},
+		{"unsupported operator", `{"collection": {"_regex": "^a"}}`, types.Int64Null(), true},
+		{"unsupported nested operator", `{"_and": [{"collection": {"_between": [1, 2]}}]}`, types.Int64Null(), true},
+		{"field condition not an object", `{"collection": "articles"}`, types.Int64Null(), true},
+		{"negative limit", "", types.Int64Value(-1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &CollectionsDataSource{}
+			schema := getDataSourceSchema(t, d)
+
+			resp := &fwdatasource.ValidateConfigResponse{}
+			d.ValidateConfig(context.Background(), fwdatasource.ValidateConfigRequest{
+				Config: makeDataSourceConfig(t, schema, newCollectionsQueryModel(tt.filter, nil, tt.limit)),
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestCollectionsDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    func() *CollectionsDataSourceModel
+		expected []string
+	}{
+		{
+			name:     "all collections in API order",
+			model:    func() *CollectionsDataSourceModel { return newCollectionsQueryModel("", nil, types.Int64Null()) },
+			expected: []string{"articles", "content", "authors", "directus_users"},
+		},
+		{
+			name: "exclude system and folders",
+			model: func() *CollectionsDataSourceModel {
+				m := newCollectionsQueryModel("", nil, types.Int64Null())
+				m.ExcludeSystem = types.BoolValue(true)
+				m.ExcludeFolders = types.BoolValue(true)
+				return m
+			},
+			expected: []string{"articles", "authors"},
+		},
+		{
+			name: "filter on meta",
+			model: func() *CollectionsDataSourceModel {
+				return newCollectionsQueryModel(`{"meta": {"group": {"_eq": "content"}, "hidden": {"_neq": true}}}`, nil, types.Int64Null())
+			},
+			expected: []string{"articles"},
+		},
+		{
+			name: "filter with _or and _starts_with",
+			model: func() *CollectionsDataSourceModel {
+				return newCollectionsQueryModel(`{"_or": [{"collection": {"_starts_with": "au"}}, {"schema": {"_null": true}}]}`, nil, types.Int64Null())
+			},
+			expected: []string{"content", "authors"},
+		},
+		{
+			name: "sort descending by nested field with limit",
+			model: func() *CollectionsDataSourceModel {
+				return newCollectionsQueryModel("", []string{"-meta.sort"}, types.Int64Value(2))
+			},
+			expected: []string{"authors", "articles"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, names := readCollectionsDataSource(t, tt.model())
+			require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestCollectionsDataSource_Read_Attributes(t *testing.T) {
+	resp, _ := readCollectionsDataSource(t, newCollectionsQueryModel(`{"collection": {"_in": ["articles", "content"]}}`, nil, types.Int64Null()))
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result CollectionsDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	require.Len(t, result.Collections, 2)
+	assert.Equal(t, "table", result.Collections[0].Kind.ValueString())
+	assert.Equal(t, "article", result.Collections[0].Icon.ValueString())
+	assert.Equal(t, "content", result.Collections[0].Group.ValueString())
+	assert.Equal(t, int64(2), result.Collections[0].Sort.ValueInt64())
+	assert.Equal(t, "folder", result.Collections[1].Kind.ValueString())
+}
+
+func TestMatchesFilterOperator(t *testing.T) {
+	tests := []struct {
+		operator string
+		value    interface{}
+		operand  interface{}
+		expected bool
+	}{
+		{"_eq", "a", "a", true},
+		{"_eq", true, "true", true},
+		{"_eq", float64(2), "2", true},
+		{"_neq", nil, "a", true},
+		{"_in", "b", []interface{}{"a", "b"}, true},
+		{"_nin", "b", []interface{}{"a", "b"}, false},
+		{"_null", nil, true, true},
+		{"_nnull", nil, true, false},
+		{"_empty", []interface{}{}, true, true},
+		{"_nempty", "x", true, true},
+		{"_contains", "articles", "tic", true},
+		{"_ncontains", "articles", "tic", false},
+		{"_icontains", "Articles", "ART", true},
+		{"_ends_with", "articles", "les", true},
+		{"_nstarts_with", "articles", "art", false},
+		{"_gt", float64(10), float64(9), true},
+		{"_gte", float64(9), float64(9), true},
+		{"_lt", float64(10), float64(9), false},
+		{"_lte", nil, float64(9), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operator, func(t *testing.T) {
+			matched, err := matchesFilterOperator(tt.value, tt.operator, tt.operand)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+
+	_, err := matchesFilterOperator("a", "_regex", "a")
+	assert.Error(t, err)
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                = &DashboardResource{}
+	_ resource.ResourceWithConfigure   = &DashboardResource{}
+	_ resource.ResourceWithImportState = &DashboardResource{}
+)
+
+// NewDashboardResource creates a new dashboard resource.
+func NewDashboardResource() resource.Resource {
+	return &DashboardResource{}
+}
+
+// DashboardResource defines the resource implementation.
+type DashboardResource struct {
+	client *client.Client
+}
+
+// DashboardResourceModel describes the resource data model.
+type DashboardResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Icon  types.String `tfsdk:"icon"`
+	Note  types.String `tfsdk:"note"`
+	Color types.String `tfsdk:"color"`
+}
+
+func (r *DashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *DashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Dashboard resource. Dashboards group panels in the Insights module. " +
+			"Panels are managed with `directus_panel`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the dashboard (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the dashboard.",
+				Required:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "The name of a Google Material Design Icon assigned to this dashboard.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "A description of the dashboard, shown in the Insights module.",
+				Optional:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "A color hex code for the dashboard icon (e.g., #6644FF).",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *DashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data dashboardAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "dashboards", buildDashboardInput(plan, true), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Dashboard",
+			"Could not create dashboard, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data dashboardAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "dashboards", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Dashboard",
+			"Could not read dashboard ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data dashboardAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "dashboards", plan.ID.ValueString(), buildDashboardInput(plan, false), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Dashboard",
+			"Could not update dashboard ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+// Delete removes the dashboard. Directus deletes the dashboard's remaining panels along with it.
+func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "dashboards", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Dashboard",
+			"Could not delete dashboard ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// dashboardAPIResponse represents the API response for dashboard operations.
+type dashboardAPIResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Icon  string `json:"icon,omitempty"`
+	Note  string `json:"note,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+// toModel converts dashboardAPIResponse to DashboardResourceModel.
+func (d *dashboardAPIResponse) toModel() *DashboardResourceModel {
+	return &DashboardResourceModel{
+		ID:    types.StringValue(d.ID),
+		Name:  types.StringValue(d.Name),
+		Icon:  stringOrNull(d.Icon),
+		Note:  stringOrNull(d.Note),
+		Color: stringOrNull(d.Color),
+	}
+}
+
+// buildDashboardInput constructs the input from the resource model (used for both create and update)
+func buildDashboardInput(data DashboardResourceModel, isCreate bool) map[string]interface{} {
+	input := map[string]interface{}{
+		"name": data.Name.ValueString(),
+	}
+
+	setStringField(input, "icon", data.Icon)
+
+	if isCreate {
+		setStringField(input, "note", data.Note)
+		setStringField(input, "color", data.Color)
+	} else {
+		setNullableStringField(input, "note", data.Note)
+		setNullableStringField(input, "color", data.Color)
+	}
+
+	return input
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDashboard_withPanels(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_dashboard", "dashboards"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_dashboard" "test" {
+  name = "AccTest Dashboard"
+  note = "Created by acceptance tests"
+}
+
+resource "directus_panel" "users" {
+  dashboard  = directus_dashboard.test.id
+  type       = "metric"
+  position_x = 1
+  position_y = 1
+  width      = 12
+  height     = 6
+
+  options = jsonencode({
+    collection = "directus_users"
+    field      = "id"
+    function   = "count"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_dashboard.test", "id"),
+					resource.TestCheckResourceAttrSet("directus_dashboard.test", "icon"),
+					resource.TestCheckResourceAttrPair("directus_panel.users", "dashboard", "directus_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("directus_panel.users", "show_header", "false"),
+				),
+			},
+			// Move and resize the panel, show its header and clear the dashboard note
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_dashboard" "test" {
+  name  = "AccTest Dashboard"
+  color = "#6644FF"
+}
+
+resource "directus_panel" "users" {
+  dashboard   = directus_dashboard.test.id
+  type        = "metric"
+  name        = "Users"
+  show_header = true
+  position_x  = 13
+  position_y  = 1
+  width       = 8
+  height      = 8
+
+  options = jsonencode({
+    collection = "directus_users"
+    field      = "id"
+    function   = "count"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("directus_dashboard.test", "note"),
+					resource.TestCheckResourceAttr("directus_dashboard.test", "color", "#6644FF"),
+					resource.TestCheckResourceAttr("directus_panel.users", "position_x", "13"),
+					resource.TestCheckResourceAttr("directus_panel.users", "show_header", "true"),
+				),
+			},
+			{
+				ResourceName:      "directus_dashboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "directus_panel.users",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestDashboardResourceSchema(t *testing.T) {
+	r := &DashboardResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "name", "icon", "note", "color"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["name"].IsRequired())
+}
+
+func TestDashboardResourceMetadata(t *testing.T) {
+	r := &DashboardResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_dashboard", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// buildDashboardInput
+// ---------------------------------------------------------------------------
+
+func TestBuildDashboardInput(t *testing.T) {
+	model := DashboardResourceModel{
+		Name:  types.StringValue("Operations"),
+		Icon:  types.StringUnknown(),
+		Note:  types.StringNull(),
+		Color: types.StringValue("#6644FF"),
+	}
+
+	t.Run("create", func(t *testing.T) {
+		input := buildDashboardInput(model, true)
+
+		assert.Equal(t, "Operations", input["name"])
+		assert.Equal(t, "#6644FF", input["color"])
+		assert.NotContains(t, input, "icon")
+		assert.NotContains(t, input, "note")
+	})
+
+	t.Run("update clears removed note", func(t *testing.T) {
+		input := buildDashboardInput(model, false)
+
+		assert.Contains(t, input, "note")
+		assert.Nil(t, input["note"])
+		assert.NotContains(t, input, "icon")
+	})
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestDashboardResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/dashboards", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "Operations", body["name"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "dash-1", "name": "Operations", "icon": "dashboard", "note": nil, "color": nil,
+			},
+		}), nil
+	})
+
+	r := &DashboardResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &DashboardResourceModel{
+		ID:    types.StringUnknown(),
+		Name:  types.StringValue("Operations"),
+		Icon:  types.StringUnknown(),
+		Note:  types.StringNull(),
+		Color: types.StringNull(),
+	})
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result DashboardResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "dash-1", result.ID.ValueString())
+	assert.Equal(t, "dashboard", result.Icon.ValueString())
+	assert.True(t, result.Note.IsNull())
+}
+
+func TestDashboardResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/dashboards/dash-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &DashboardResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &DashboardResourceModel{
+		ID:    types.StringValue("dash-1"),
+		Name:  types.StringValue("Operations"),
+		Icon:  types.StringValue("dashboard"),
+		Note:  types.StringNull(),
+		Color: types.StringNull(),
+	})
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &ExtensionResource{}
+	_ resource.ResourceWithConfigure      = &ExtensionResource{}
+	_ resource.ResourceWithImportState    = &ExtensionResource{}
+	_ resource.ResourceWithValidateConfig = &ExtensionResource{}
+)
+
+// NewExtensionResource creates a new extension resource.
+func NewExtensionResource() resource.Resource {
+	return &ExtensionResource{}
+}
+
+// ExtensionResource defines the resource implementation.
+type ExtensionResource struct {
+	client *client.Client
+}
+
+// ExtensionResourceModel describes the resource data model.
+type ExtensionResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Bundle              types.String `tfsdk:"bundle"`
+	RegistryExtensionID types.String `tfsdk:"registry_extension_id"`
+	RegistryVersionID   types.String `tfsdk:"registry_version_id"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	Type                types.String `tfsdk:"type"`
+	Version             types.String `tfsdk:"version"`
+	Source              types.String `tfsdk:"source"`
+}
+
+func (r *ExtensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extension"
+}
+
+func (r *ExtensionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Extension resource. Enables or disables an installed extension, bundle or bundle entry, " +
+			"or installs an extension from the extension registry configured on the server (`MARKETPLACE_REGISTRY`). " +
+			"Destroying the resource uninstalls registry-installed extensions and leaves other extensions untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the extension (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The package name of an installed extension, or the name of an entry when `bundle` is set. " +
+					"Required unless `registry_extension_id` is set. Changing this forces a new resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bundle": schema.StringAttribute{
+				MarkdownDescription: "The package name of the bundle containing the entry `name`. Changing this forces a new resource.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registry_extension_id": schema.StringAttribute{
+				MarkdownDescription: "The registry ID of an extension to install. Changing this forces a new resource.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registry_version_id": schema.StringAttribute{
+				MarkdownDescription: "The registry ID of the version to install. Required with `registry_extension_id`. " +
+					"Changing this forces a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the extension is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The extension type, e.g. `interface`, `hook` or `bundle`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The installed version of the extension, if reported.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Where the extension was installed from: `local`, `module` or `registry`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the extension is selected either by name or by registry ID.
+func (r *ExtensionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ExtensionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() && data.RegistryExtensionID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Extension Configuration",
+			"One of name or registry_extension_id must be set.",
+		)
+	}
+	if !data.RegistryExtensionID.IsNull() && data.RegistryVersionID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("registry_version_id"),
+			"Invalid Extension Configuration",
+			"registry_version_id is required when registry_extension_id is set.",
+		)
+	}
+	if data.RegistryExtensionID.IsNull() && !data.RegistryVersionID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("registry_version_id"),
+			"Invalid Extension Configuration",
+			"registry_version_id can only be set together with registry_extension_id.",
+		)
+	}
+	if !data.Bundle.IsNull() && !data.RegistryExtensionID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bundle"),
+			"Invalid Extension Configuration",
+			"bundle cannot be set when installing from the registry; manage bundle entries with a separate resource.",
+		)
+	}
+}
+
+func (r *ExtensionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create installs the extension from the registry when requested, then looks it up
+// and applies the enabled flag.
+func (r *ExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ExtensionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RegistryExtensionID.IsNull() {
+		if err := r.client.InstallExtension(ctx, plan.RegistryExtensionID.ValueString(), plan.RegistryVersionID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Installing Extension",
+				"Could not install extension "+plan.RegistryExtensionID.ValueString()+" from the registry: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	extensions, err := r.listExtensions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Extensions",
+			"Could not list extensions: "+err.Error(),
+		)
+		return
+	}
+
+	ext, err := findExtension(extensions, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Finding Extension", err.Error())
+		return
+	}
+
+	if ext.Meta.Enabled != plan.Enabled.ValueBool() {
+		if ext, err = r.setEnabled(ctx, ext, plan.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Extension",
+				"Could not update extension "+ext.ID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, ext.toModel(plan, extensions))...)
+}
+
+func (r *ExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ExtensionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extensions, err := r.listExtensions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Extension",
+			"Could not read extension ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var ext *extensionAPIResponse
+	for i := range extensions {
+		if extensions[i].ID == state.ID.ValueString() {
+			ext = &extensions[i]
+			break
+		}
+	}
+	if ext == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Extension",
+			"Extension ID "+state.ID.ValueString()+" is not installed.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, ext.toModel(state, extensions))...)
+}
+
+// Update toggles the enabled flag, the only attribute that can change in place.
+func (r *ExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ExtensionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extensions, err := r.listExtensions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Extensions",
+			"Could not list extensions: "+err.Error(),
+		)
+		return
+	}
+
+	ext, err := r.setEnabled(ctx, &extensionAPIResponse{ID: plan.ID.ValueString()}, plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Extension",
+			"Could not update extension ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, ext.toModel(plan, extensions))...)
+}
+
+// Delete uninstalls extensions installed from the registry. Other extensions are only
+// removed from state, since they are installed through the server's file system.
+func (r *ExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ExtensionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.RegistryExtensionID.IsNull() {
+		return
+	}
+
+	if err := r.client.UninstallExtension(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Uninstalling Extension",
+			"Could not uninstall extension ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// listExtensions returns all installed extensions, including bundle entries.
+func (r *ExtensionResource) listExtensions(ctx context.Context) ([]extensionAPIResponse, error) {
+	var result struct {
+		Data []extensionAPIResponse `json:"data"`
+	}
+
+	if err := r.client.List(ctx, "extensions", &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// setEnabled enables or disables an extension and returns the updated extension.
+func (r *ExtensionResource) setEnabled(ctx context.Context, ext *extensionAPIResponse, enabled bool) (*extensionAPIResponse, error) {
+	input := map[string]interface{}{
+		"meta": map[string]interface{}{"enabled": enabled},
+	}
+
+	var response struct {
+		Data extensionAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "extensions", ext.ID, input, &response); err != nil {
+		return ext, err
+	}
+
+	return &response.Data, nil
+}
+
+// findExtension selects the extension described by the model: a registry install by its
+// folder, a bundle entry by bundle and entry name, or a top-level extension by name.
+func findExtension(extensions []extensionAPIResponse, data ExtensionResourceModel) (*extensionAPIResponse, error) {
+	if !data.RegistryExtensionID.IsNull() {
+		for i, ext := range extensions {
+			if ext.Meta.Source == "registry" && ext.Meta.Folder == data.RegistryExtensionID.ValueString() {
+				return &extensions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("extension %s was installed from the registry but is not listed by the server", data.RegistryExtensionID.ValueString())
+	}
+
+	name := data.Name.ValueString()
+
+	if data.Bundle.IsNull() {
+		for i, ext := range extensions {
+			if ext.Bundle == "" && ext.Schema.Name == name {
+				return &extensions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("extension %q is not installed", name)
+	}
+
+	bundle := data.Bundle.ValueString()
+	var bundleID string
+	for _, ext := range extensions {
+		if ext.Bundle == "" && ext.Schema.Name == bundle {
+			bundleID = ext.ID
+			break
+		}
+	}
+	if bundleID == "" {
+		return nil, fmt.Errorf("bundle %q is not installed", bundle)
+	}
+
+	for i, ext := range extensions {
+		if ext.Bundle == bundleID && ext.Schema.Name == name {
+			return &extensions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("bundle %q has no entry %q", bundle, name)
+}
+
+// extensionAPIResponse represents an extension as listed by GET /extensions.
+type extensionAPIResponse struct {
+	ID     string `json:"id"`
+	Bundle string `json:"bundle,omitempty"`
+	Schema struct {
+		Type    string `json:"type,omitempty"`
+		Name    string `json:"name,omitempty"`
+		Version string `json:"version,omitempty"`
+	} `json:"schema"`
+	Meta struct {
+		Enabled bool   `json:"enabled"`
+		Source  string `json:"source,omitempty"`
+		Folder  string `json:"folder,omitempty"`
+	} `json:"meta"`
+}
+
+// toModel converts extensionAPIResponse to ExtensionResourceModel. The bundle name is
+// resolved from the list of extensions; the registry IDs are carried over from prior.
+func (e *extensionAPIResponse) toModel(prior ExtensionResourceModel, extensions []extensionAPIResponse) *ExtensionResourceModel {
+	model := &ExtensionResourceModel{
+		ID:                  types.StringValue(e.ID),
+		Name:                stringOrNull(e.Schema.Name),
+		Bundle:              types.StringNull(),
+		RegistryExtensionID: prior.RegistryExtensionID,
+		RegistryVersionID:   prior.RegistryVersionID,
+		Enabled:             types.BoolValue(e.Meta.Enabled),
+		Type:                stringOrNull(e.Schema.Type),
+		Version:             stringOrNull(e.Schema.Version),
+		Source:              stringOrNull(e.Meta.Source),
+	}
+
+	if e.Bundle != "" {
+		for _, ext := range extensions {
+			if ext.ID == e.Bundle {
+				model.Bundle = stringOrNull(ext.Schema.Name)
+				break
+			}
+		}
+	}
+
+	return model
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccExtension_basic toggles an extension that is already installed on the test
+// server. Set DIRECTUS_TEST_EXTENSION to its package name to run it.
+func TestAccExtension_basic(t *testing.T) {
+	name := os.Getenv("DIRECTUS_TEST_EXTENSION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if name == "" {
+				t.Skip("DIRECTUS_TEST_EXTENSION must be set to an installed extension for this test")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccExtensionConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_extension.test", "id"),
+					resource.TestCheckResourceAttrSet("directus_extension.test", "type"),
+					resource.TestCheckResourceAttr("directus_extension.test", "name", name),
+					resource.TestCheckResourceAttr("directus_extension.test", "enabled", "false"),
+				),
+			},
+			// Re-enable
+			{
+				Config: testAccProviderConfig() + testAccExtensionConfig(name, true),
+				Check:  resource.TestCheckResourceAttr("directus_extension.test", "enabled", "true"),
+			},
+			// ImportState
+			{
+				ResourceName:      "directus_extension.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccExtensionConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "directus_extension" "test" {
+  name    = %q
+  enabled = %t
+}
+`, name, enabled)
+}
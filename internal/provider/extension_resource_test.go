@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestExtensionResourceSchema(t *testing.T) {
+	r := &ExtensionResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "name", "bundle", "registry_extension_id", "registry_version_id", "enabled", "type", "version", "source"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["type"].IsComputed())
+}
+
+func TestExtensionResourceMetadata(t *testing.T) {
+	r := &ExtensionResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_extension", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newExtensionModel() *ExtensionResourceModel {
+	return &ExtensionResourceModel{
+		ID:                  types.StringNull(),
+		Name:                types.StringNull(),
+		Bundle:              types.StringNull(),
+		RegistryExtensionID: types.StringNull(),
+		RegistryVersionID:   types.StringNull(),
+		Enabled:             types.BoolNull(),
+		Type:                types.StringNull(),
+		Version:             types.StringNull(),
+		Source:              types.StringNull(),
+	}
+}
+
+func TestExtensionResource_ValidateConfig(t *testing.T) {
+	r := &ExtensionResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *ExtensionResourceModel)
+		expectErr bool
+	}{
+		{"name", func(m *ExtensionResourceModel) { m.Name = types.StringValue("directus-extension-seo") }, false},
+		{"bundle entry", func(m *ExtensionResourceModel) {
+			m.Name = types.StringValue("seo-interface")
+			m.Bundle = types.StringValue("directus-extension-seo")
+		}, false},
+		{"registry", func(m *ExtensionResourceModel) {
+			m.RegistryExtensionID = types.StringValue("ext-1")
+			m.RegistryVersionID = types.StringValue("ver-1")
+		}, false},
+		{"nothing selected", func(m *ExtensionResourceModel) {}, true},
+		{"registry without version", func(m *ExtensionResourceModel) { m.RegistryExtensionID = types.StringValue("ext-1") }, true},
+		{"version without registry", func(m *ExtensionResourceModel) {
+			m.Name = types.StringValue("directus-extension-seo")
+			m.RegistryVersionID = types.StringValue("ver-1")
+		}, true},
+		{"bundle with registry", func(m *ExtensionResourceModel) {
+			m.Name = types.StringValue("seo-interface")
+			m.Bundle = types.StringValue("directus-extension-seo")
+			m.RegistryExtensionID = types.StringValue("ext-1")
+			m.RegistryVersionID = types.StringValue("ver-1")
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newExtensionModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// findExtension / toModel
+// ---------------------------------------------------------------------------
+
+func testExtensions(t *testing.T) []extensionAPIResponse {
+	t.Helper()
+
+	var extensions []extensionAPIResponse
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"id": "bundle-1", "bundle": null, "schema": {"type": "bundle", "name": "directus-extension-seo", "version": "1.2.0"}, "meta": {"enabled": true, "source": "local", "folder": "directus-extension-seo"}},
+		{"id": "entry-1", "bundle": "bundle-1", "schema": {"type": "interface", "name": "seo-interface"}, "meta": {"enabled": true, "source": "local", "folder": "seo-interface", "bundle": "bundle-1"}},
+		{"id": "other-1", "bundle": null, "schema": {"type": "interface", "name": "seo-interface"}, "meta": {"enabled": false, "source": "module", "folder": "seo-interface"}},
+		{"id": "reg-1", "bundle": null, "schema": {"type": "hook", "name": "audit-hook", "version": "0.3.1"}, "meta": {"enabled": true, "source": "registry", "folder": "ext-1"}}
+	]`), &extensions))
+
+	return extensions
+}
+
+func TestFindExtension(t *testing.T) {
+	extensions := testExtensions(t)
+
+	tests := []struct {
+		name    string
+		modify  func(m *ExtensionResourceModel)
+		wantID  string
+		wantErr bool
+	}{
+		{"top-level by name", func(m *ExtensionResourceModel) { m.Name = types.StringValue("seo-interface") }, "other-1", false},
+		{"bundle entry", func(m *ExtensionResourceModel) {
+			m.Name = types.StringValue("seo-interface")
+			m.Bundle = types.StringValue("directus-extension-seo")
+		}, "entry-1", false},
+		{"registry folder", func(m *ExtensionResourceModel) { m.RegistryExtensionID = types.StringValue("ext-1") }, "reg-1", false},
+		{"unknown name", func(m *ExtensionResourceModel) { m.Name = types.StringValue("missing") }, "", true},
+		{"unknown bundle", func(m *ExtensionResourceModel) {
+			m.Name = types.StringValue("seo-interface")
+			m.Bundle = types.StringValue("missing")
+		}, "", true},
+		{"unknown entry", func(m *ExtensionResourceModel) {
+			m.Name = types.StringValue("missing")
+			m.Bundle = types.StringValue("directus-extension-seo")
+		}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newExtensionModel()
+			tt.modify(model)
+
+			ext, err := findExtension(extensions, *model)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, ext.ID)
+		})
+	}
+}
+
+func TestExtensionAPIResponseToModel(t *testing.T) {
+	extensions := testExtensions(t)
+
+	model := extensions[1].toModel(*newExtensionModel(), extensions)
+	assert.Equal(t, "entry-1", model.ID.ValueString())
+	assert.Equal(t, "seo-interface", model.Name.ValueString())
+	assert.Equal(t, "directus-extension-seo", model.Bundle.ValueString())
+	assert.Equal(t, "interface", model.Type.ValueString())
+	assert.True(t, model.Version.IsNull())
+	assert.True(t, model.Enabled.ValueBool())
+
+	prior := newExtensionModel()
+	prior.RegistryExtensionID = types.StringValue("ext-1")
+	prior.RegistryVersionID = types.StringValue("ver-1")
+	model = extensions[3].toModel(*prior, extensions)
+	assert.True(t, model.Bundle.IsNull())
+	assert.Equal(t, "registry", model.Source.ValueString())
+	assert.Equal(t, "ver-1", model.RegistryVersionID.ValueString())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func testExtensionsListResponse(t *testing.T) *http.Response {
+	return mockJSONResponse(200, map[string]interface{}{"data": testExtensions(t)})
+}
+
+func TestExtensionResource_Create_DisablesBundleEntry(t *testing.T) {
+	var patched bool
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case "GET":
+			assert.Equal(t, "http://example.com/extensions", req.URL.String())
+			return testExtensionsListResponse(t), nil
+		case "PATCH":
+			assert.Equal(t, "http://example.com/extensions/entry-1", req.URL.String())
+
+			var body map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, map[string]interface{}{"enabled": false}, body["meta"])
+			patched = true
+
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{
+					"id": "entry-1", "bundle": "bundle-1",
+					"schema": map[string]interface{}{"type": "interface", "name": "seo-interface"},
+					"meta":   map[string]interface{}{"enabled": false, "source": "local"},
+				},
+			}), nil
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &ExtensionResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := newExtensionModel()
+	plan.ID = types.StringUnknown()
+	plan.Name = types.StringValue("seo-interface")
+	plan.Bundle = types.StringValue("directus-extension-seo")
+	plan.Enabled = types.BoolValue(false)
+	plan.Type = types.StringUnknown()
+	plan.Version = types.StringUnknown()
+	plan.Source = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, plan)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+	assert.True(t, patched)
+
+	var result ExtensionResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "entry-1", result.ID.ValueString())
+	assert.Equal(t, "directus-extension-seo", result.Bundle.ValueString())
+	assert.False(t, result.Enabled.ValueBool())
+}
+
+func TestExtensionResource_Create_InstallsFromRegistry(t *testing.T) {
+	var installed bool
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case "POST":
+			assert.Equal(t, "http://example.com/extensions/registry/install", req.URL.String())
+
+			var body map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, "ext-1", body["extension"])
+			assert.Equal(t, "ver-1", body["version"])
+			installed = true
+
+			return mockJSONResponse(204, nil), nil
+		case "GET":
+			return testExtensionsListResponse(t), nil
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &ExtensionResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := newExtensionModel()
+	plan.ID = types.StringUnknown()
+	plan.Name = types.StringUnknown()
+	plan.RegistryExtensionID = types.StringValue("ext-1")
+	plan.RegistryVersionID = types.StringValue("ver-1")
+	plan.Enabled = types.BoolValue(true)
+	plan.Type = types.StringUnknown()
+	plan.Version = types.StringUnknown()
+	plan.Source = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, plan)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+	assert.True(t, installed)
+
+	var result ExtensionResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "reg-1", result.ID.ValueString())
+	assert.Equal(t, "audit-hook", result.Name.ValueString())
+	assert.Equal(t, "0.3.1", result.Version.ValueString())
+}
+
+func TestExtensionResource_Read_NotInstalled(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return testExtensionsListResponse(t), nil
+	})
+
+	r := &ExtensionResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newExtensionModel()
+	model.ID = types.StringValue("gone-1")
+	model.Name = types.StringValue("gone")
+	model.Enabled = types.BoolValue(true)
+
+	resp := &fwresource.ReadResponse{State: makeState(t, schema, model)}
+	r.Read(context.Background(), fwresource.ReadRequest{State: makeState(t, schema, model)}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestExtensionResource_Delete(t *testing.T) {
+	t.Run("registry install is uninstalled", func(t *testing.T) {
+		var uninstalled bool
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "DELETE", req.Method)
+			assert.Equal(t, "http://example.com/extensions/registry/uninstall/reg-1", req.URL.String())
+			uninstalled = true
+			return mockJSONResponse(204, nil), nil
+		})
+
+		r := &ExtensionResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		model := newExtensionModel()
+		model.ID = types.StringValue("reg-1")
+		model.RegistryExtensionID = types.StringValue("ext-1")
+		model.RegistryVersionID = types.StringValue("ver-1")
+		state := makeState(t, schema, model)
+
+		resp := &fwresource.DeleteResponse{State: state}
+		r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+		assert.True(t, uninstalled)
+	})
+
+	t.Run("local extension is left installed", func(t *testing.T) {
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+			return nil, nil
+		})
+
+		r := &ExtensionResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		model := newExtensionModel()
+		model.ID = types.StringValue("bundle-1")
+		model.Name = types.StringValue("directus-extension-seo")
+		state := makeState(t, schema, model)
+
+		resp := &fwresource.DeleteResponse{State: state}
+		r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+	})
+}
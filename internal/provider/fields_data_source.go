@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &FieldsDataSource{}
+	_ datasource.DataSourceWithConfigure = &FieldsDataSource{}
+)
+
+// NewFieldsDataSource creates a new fields data source.
+func NewFieldsDataSource() datasource.DataSource {
+	return &FieldsDataSource{}
+}
+
+// FieldsDataSource defines the data source implementation.
+type FieldsDataSource struct {
+	client *client.Client
+}
+
+// FieldsDataSourceModel describes the data source data model.
+type FieldsDataSourceModel struct {
+	Collection types.String           `tfsdk:"collection"`
+	Fields     []FieldDataSourceModel `tfsdk:"fields"`
+}
+
+// FieldDataSourceModel describes a field returned by the data source. Meta and schema
+// information is flattened into a single object.
+type FieldDataSourceModel struct {
+	Field            types.String `tfsdk:"field"`
+	Type             types.String `tfsdk:"type"`
+	Interface        types.String `tfsdk:"interface"`
+	Display          types.String `tfsdk:"display"`
+	Special          types.List   `tfsdk:"special"`
+	Hidden           types.Bool   `tfsdk:"hidden"`
+	Readonly         types.Bool   `tfsdk:"readonly"`
+	Required         types.Bool   `tfsdk:"required"`
+	Note             types.String `tfsdk:"note"`
+	Sort             types.Int64  `tfsdk:"sort"`
+	Group            types.String `tfsdk:"group"`
+	DataType         types.String `tfsdk:"data_type"`
+	DefaultValue     types.String `tfsdk:"default_value"`
+	MaxLength        types.Int64  `tfsdk:"max_length"`
+	IsNullable       types.Bool   `tfsdk:"is_nullable"`
+	IsUnique         types.Bool   `tfsdk:"is_unique"`
+	IsPrimaryKey     types.Bool   `tfsdk:"is_primary_key"`
+	HasAutoIncrement types.Bool   `tfsdk:"has_auto_increment"`
+	ForeignKeyTable  types.String `tfsdk:"foreign_key_table"`
+	ForeignKeyColumn types.String `tfsdk:"foreign_key_column"`
+}
+
+func (d *FieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fields"
+}
+
+func (d *FieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the fields of a Directus collection, e.g. to grant permissions on every field.",
+
+		Attributes: map[string]schema.Attribute{
+			"collection": schema.StringAttribute{
+				MarkdownDescription: "The name of the collection.",
+				Required:            true,
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The fields of the collection, in the order Directus returns them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							MarkdownDescription: "The name of the field.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The Directus data type, e.g. `string`, `integer`, `uuid`, or `alias` for fields without a database column.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "The interface used to edit the field in the Data Studio.",
+							Computed:            true,
+						},
+						"display": schema.StringAttribute{
+							MarkdownDescription: "The display used to render the field in the Data Studio.",
+							Computed:            true,
+						},
+						"special": schema.ListAttribute{
+							MarkdownDescription: "Special flags of the field, e.g. `uuid`, `date-created`, `m2o`, or `o2m`.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is hidden on the item detail page.",
+							Computed:            true,
+						},
+						"readonly": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is read-only in the Data Studio.",
+							Computed:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether a value is required when saving an item.",
+							Computed:            true,
+						},
+						"note": schema.StringAttribute{
+							MarkdownDescription: "The note shown below the field in the Data Studio.",
+							Computed:            true,
+						},
+						"sort": schema.Int64Attribute{
+							MarkdownDescription: "The position of the field in the item form.",
+							Computed:            true,
+						},
+						"group": schema.StringAttribute{
+							MarkdownDescription: "The group field this field belongs to.",
+							Computed:            true,
+						},
+						"data_type": schema.StringAttribute{
+							MarkdownDescription: "The database column type. Null for fields without a database column.",
+							Computed:            true,
+						},
+						"default_value": schema.StringAttribute{
+							MarkdownDescription: "The database default value as JSON, e.g. `\"draft\"` or `0`.",
+							Computed:            true,
+						},
+						"max_length": schema.Int64Attribute{
+							MarkdownDescription: "The maximum length of the column.",
+							Computed:            true,
+						},
+						"is_nullable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the column accepts null values.",
+							Computed:            true,
+						},
+						"is_unique": schema.BoolAttribute{
+							MarkdownDescription: "Whether the column has a unique constraint.",
+							Computed:            true,
+						},
+						"is_primary_key": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is the primary key of the collection.",
+							Computed:            true,
+						},
+						"has_auto_increment": schema.BoolAttribute{
+							MarkdownDescription: "Whether the column is auto-incremented.",
+							Computed:            true,
+						},
+						"foreign_key_table": schema.StringAttribute{
+							MarkdownDescription: "The table referenced by the foreign key of the column.",
+							Computed:            true,
+						},
+						"foreign_key_column": schema.StringAttribute{
+							MarkdownDescription: "The column referenced by the foreign key of the column.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *FieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FieldsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Data []fieldAPIResponse `json:"data"`
+	}
+	if err := d.client.Get(ctx, "fields", data.Collection.ValueString(), &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Fields",
+			fmt.Sprintf("Could not read fields of collection %s: %s", data.Collection.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Fields = make([]FieldDataSourceModel, len(result.Data))
+	for i, field := range result.Data {
+		data.Fields[i] = *field.toDataSourceModel()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fieldAPIResponse is a field as returned by GET /fields/{collection}. It follows the
+// Field shape in reference/models.
+type fieldAPIResponse struct {
+	Field string `json:"field"`
+	Type  string `json:"type"`
+	Meta  *struct {
+		Special   []string `json:"special,omitempty"`
+		Interface string   `json:"interface,omitempty"`
+		Display   string   `json:"display,omitempty"`
+		Readonly  bool     `json:"readonly,omitempty"`
+		Hidden    bool     `json:"hidden,omitempty"`
+		Required  bool     `json:"required,omitempty"`
+		Note      string   `json:"note,omitempty"`
+		Sort      *int64   `json:"sort,omitempty"`
+		Group     string   `json:"group,omitempty"`
+	} `json:"meta,omitempty"`
+	Schema *struct {
+		DataType         string          `json:"data_type,omitempty"`
+		DefaultValue     json.RawMessage `json:"default_value,omitempty"`
+		MaxLength        *int64          `json:"max_length,omitempty"`
+		IsNullable       bool            `json:"is_nullable,omitempty"`
+		IsUnique         bool            `json:"is_unique,omitempty"`
+		IsPrimaryKey     bool            `json:"is_primary_key,omitempty"`
+		HasAutoIncrement bool            `json:"has_auto_increment,omitempty"`
+		ForeignKeyTable  string          `json:"foreign_key_table,omitempty"`
+		ForeignKeyColumn string          `json:"foreign_key_column,omitempty"`
+	} `json:"schema,omitempty"`
+}
+
+// toDataSourceModel converts fieldAPIResponse to FieldDataSourceModel. Fields without
+// meta (e.g. columns created outside Directus) or schema (alias fields) have null
+// values for the missing part.
+func (f *fieldAPIResponse) toDataSourceModel() *FieldDataSourceModel {
+	field := &FieldDataSourceModel{
+		Field:            types.StringValue(f.Field),
+		Type:             types.StringValue(f.Type),
+		Interface:        types.StringNull(),
+		Display:          types.StringNull(),
+		Special:          types.ListNull(types.StringType),
+		Hidden:           types.BoolValue(false),
+		Readonly:         types.BoolValue(false),
+		Required:         types.BoolValue(false),
+		Note:             types.StringNull(),
+		Sort:             types.Int64Null(),
+		Group:            types.StringNull(),
+		DataType:         types.StringNull(),
+		DefaultValue:     types.StringNull(),
+		MaxLength:        types.Int64Null(),
+		IsNullable:       types.BoolNull(),
+		IsUnique:         types.BoolNull(),
+		IsPrimaryKey:     types.BoolValue(false),
+		HasAutoIncrement: types.BoolValue(false),
+		ForeignKeyTable:  types.StringNull(),
+		ForeignKeyColumn: types.StringNull(),
+	}
+
+	if f.Meta != nil {
+		field.Interface = stringOrNull(f.Meta.Interface)
+		field.Display = stringOrNull(f.Meta.Display)
+		field.Special = stringListOrNull(f.Meta.Special)
+		field.Hidden = types.BoolValue(f.Meta.Hidden)
+		field.Readonly = types.BoolValue(f.Meta.Readonly)
+		field.Required = types.BoolValue(f.Meta.Required)
+		field.Note = stringOrNull(f.Meta.Note)
+		if f.Meta.Sort != nil {
+			field.Sort = types.Int64Value(*f.Meta.Sort)
+		}
+		field.Group = stringOrNull(f.Meta.Group)
+	}
+
+	if f.Schema != nil {
+		field.DataType = stringOrNull(f.Schema.DataType)
+		field.DefaultValue = jsonStringValue(types.StringNull(), f.Schema.DefaultValue)
+		if f.Schema.MaxLength != nil {
+			field.MaxLength = types.Int64Value(*f.Schema.MaxLength)
+		}
+		field.IsNullable = types.BoolValue(f.Schema.IsNullable)
+		field.IsUnique = types.BoolValue(f.Schema.IsUnique)
+		field.IsPrimaryKey = types.BoolValue(f.Schema.IsPrimaryKey)
+		field.HasAutoIncrement = types.BoolValue(f.Schema.HasAutoIncrement)
+		field.ForeignKeyTable = stringOrNull(f.Schema.ForeignKeyTable)
+		field.ForeignKeyColumn = stringOrNull(f.Schema.ForeignKeyColumn)
+	}
+
+	return field
+}
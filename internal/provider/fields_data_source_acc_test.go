@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFieldsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_collection" "test" {
+  collection = "acc_test_fields_ds"
+}
+
+data "directus_fields" "test" {
+  collection = directus_collection.test.collection
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.directus_fields.test", "fields.#", "1"),
+					resource.TestCheckResourceAttr("data.directus_fields.test", "fields.0.field", "id"),
+					resource.TestCheckResourceAttr("data.directus_fields.test", "fields.0.is_primary_key", "true"),
+				),
+			},
+		},
+	})
+}
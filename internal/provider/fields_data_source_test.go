@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldsDataSource_Metadata(t *testing.T) {
+	d := &FieldsDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_fields", resp.TypeName)
+}
+
+func TestFieldsDataSource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/fields/articles", req.URL.Path)
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []interface{}{
+				map[string]interface{}{
+					"field": "id",
+					"type":  "integer",
+					"meta":  map[string]interface{}{"interface": "input", "readonly": true, "hidden": true, "sort": 1},
+					"schema": map[string]interface{}{
+						"data_type":          "integer",
+						"default_value":      nil,
+						"is_nullable":        false,
+						"is_unique":          true,
+						"is_primary_key":     true,
+						"has_auto_increment": true,
+					},
+				},
+				map[string]interface{}{
+					"field": "status",
+					"type":  "string",
+					"meta":  map[string]interface{}{"interface": "select-dropdown", "required": true},
+					"schema": map[string]interface{}{
+						"data_type":     "character varying",
+						"default_value": "draft",
+						"max_length":    255,
+						"is_nullable":   false,
+					},
+				},
+				map[string]interface{}{
+					"field": "author",
+					"type":  "uuid",
+					"meta":  map[string]interface{}{"special": []string{"m2o"}},
+					"schema": map[string]interface{}{
+						"data_type":          "uuid",
+						"is_nullable":        true,
+						"foreign_key_table":  "directus_users",
+						"foreign_key_column": "id",
+					},
+				},
+				map[string]interface{}{
+					"field":  "comments",
+					"type":   "alias",
+					"meta":   map[string]interface{}{"special": []string{"o2m"}, "interface": "list-o2m"},
+					"schema": nil,
+				},
+			},
+		}), nil
+	})
+
+	d := &FieldsDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, &FieldsDataSourceModel{
+		Collection: types.StringValue("articles"),
+	})}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result FieldsDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	require.Len(t, result.Fields, 4)
+
+	id := result.Fields[0]
+	assert.Equal(t, "id", id.Field.ValueString())
+	assert.True(t, id.IsPrimaryKey.ValueBool())
+	assert.True(t, id.HasAutoIncrement.ValueBool())
+	assert.True(t, id.Readonly.ValueBool())
+	assert.True(t, id.DefaultValue.IsNull())
+	assert.Equal(t, int64(1), id.Sort.ValueInt64())
+
+	status := result.Fields[1]
+	assert.Equal(t, `"draft"`, status.DefaultValue.ValueString())
+	assert.Equal(t, int64(255), status.MaxLength.ValueInt64())
+	assert.True(t, status.Required.ValueBool())
+	assert.True(t, status.Special.IsNull())
+
+	author := result.Fields[2]
+	assert.Equal(t, []string{"m2o"}, stringListValues(author.Special))
+	assert.Equal(t, "directus_users", author.ForeignKeyTable.ValueString())
+	assert.True(t, author.IsNullable.ValueBool())
+
+	comments := result.Fields[3]
+	assert.Equal(t, "alias", comments.Type.ValueString())
+	assert.True(t, comments.DataType.IsNull())
+	assert.True(t, comments.IsNullable.IsNull())
+	assert.False(t, comments.IsPrimaryKey.ValueBool())
+}
+
+func TestFieldsDataSource_Read_Error(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return mockErrorResponse(403, "You don't have permission to access this."), nil
+	})
+
+	d := &FieldsDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, &FieldsDataSourceModel{
+		Collection: types.StringValue("missing"),
+	})}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Error Reading Fields", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,551 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &FileResource{}
+	_ resource.ResourceWithConfigure      = &FileResource{}
+	_ resource.ResourceWithImportState    = &FileResource{}
+	_ resource.ResourceWithModifyPlan     = &FileResource{}
+	_ resource.ResourceWithValidateConfig = &FileResource{}
+)
+
+// NewFileResource creates a new file resource.
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+// FileResource manages a file in the Directus File Library, uploaded either
+// from a local path or imported from a remote URL.
+type FileResource struct {
+	client *client.Client
+}
+
+// FileResourceModel describes the resource data model.
+type FileResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Source           types.String `tfsdk:"source"`
+	SourceHash       types.String `tfsdk:"source_hash"`
+	URL              types.String `tfsdk:"url"`
+	Title            types.String `tfsdk:"title"`
+	Description      types.String `tfsdk:"description"`
+	Folder           types.String `tfsdk:"folder"`
+	Tags             types.List   `tfsdk:"tags"`
+	Metadata         types.String `tfsdk:"metadata"`
+	Type             types.String `tfsdk:"type"`
+	Filesize         types.Int64  `tfsdk:"filesize"`
+	FilenameDownload types.String `tfsdk:"filename_download"`
+}
+
+func (r *FileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus File resource. Uploads a local file (`source`) or imports a remote file (`url`) " +
+			"into the File Library. Changes to the content of a local file are detected through its SHA-256 hash " +
+			"and replace the file contents in place, keeping the file ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the file (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to a local file to upload. Conflicts with `url`.",
+				Optional:            true,
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the local `source` file, computed at plan time. A change triggers a content replacement.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Remote URL to import the file from. Conflicts with `source`. Changing this value imports a new file.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the file. Directus derives a title from the file name when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the file.",
+				Optional:            true,
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the folder the file is placed in.",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags for the file.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"metadata": schema.StringAttribute{
+				MarkdownDescription: "JSON object of custom metadata. Only the keys set here are tracked; " +
+					"they are merged into the metadata Directus extracts from the file itself (e.g. EXIF), which is kept.",
+				Optional: true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "MIME type of the file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filesize": schema.Int64Attribute{
+				MarkdownDescription: "Size of the file in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"filename_download": schema.StringAttribute{
+				MarkdownDescription: "File name used when the file is downloaded.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures exactly one content origin is configured and metadata is valid JSON.
+func (r *FileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateJSONString(data.Metadata); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid File Metadata", err.Error())
+	}
+
+	if data.Source.IsUnknown() || data.URL.IsUnknown() {
+		return
+	}
+
+	if !data.Source.IsNull() && !data.URL.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Conflicting File Origin",
+			"Only one of source or url can be set on a directus_file.",
+		)
+	}
+
+	if data.Source.IsNull() && data.URL.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Missing File Origin",
+			"One of source or url must be set on a directus_file.",
+		)
+	}
+}
+
+// ModifyPlan hashes the local source file so that content changes show up in the plan.
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.Source.IsUnknown():
+		plan.SourceHash = types.StringUnknown()
+	case plan.Source.IsNull():
+		plan.SourceHash = types.StringNull()
+	default:
+		hash, err := hashFile(plan.Source.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source"),
+				"Error Reading Source File",
+				fmt.Sprintf("Could not hash file %s: %s", plan.Source.ValueString(), err.Error()),
+			)
+			return
+		}
+		plan.SourceHash = types.StringValue(hash)
+	}
+
+	// Replacing the content changes the properties Directus derives from it.
+	if !req.State.Raw.IsNull() {
+		var state FileResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if fileContentChanged(state, plan) {
+			plan.Type = types.StringUnknown()
+			plan.Filesize = types.Int64Unknown()
+			plan.FilenameDownload = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *FileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := buildFileInput(ctx, plan, true)
+
+	var response struct {
+		Data fileAPIResponse `json:"data"`
+	}
+
+	if !plan.URL.IsNull() {
+		if err := r.client.ImportFile(ctx, plan.URL.ValueString(), input, &response); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing File",
+				fmt.Sprintf("Could not import file from %s: %s", plan.URL.ValueString(), err.Error()),
+			)
+			return
+		}
+	} else {
+		if err := r.uploadSource(ctx, "", plan.Source.ValueString(), &response); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Uploading File",
+				fmt.Sprintf("Could not upload file %s: %s", plan.Source.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		// Directus only accepts file fields reliably as JSON, so they are set after the upload.
+		// Metadata extracted during the upload is kept.
+		if _, ok := input["metadata"]; ok {
+			input["metadata"] = mergeFileMetadata(response.Data.Metadata, plan.Metadata, types.StringNull())
+		}
+		if len(input) > 0 {
+			fileID := response.Data.ID
+			if err := r.client.Update(ctx, "files", fileID, input, &response); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating File",
+					fmt.Sprintf("Could not set fields on uploaded file %s: %s", fileID, err.Error()),
+				)
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data fileAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "files", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading File",
+			fmt.Sprintf("Could not read file %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(state))...)
+}
+
+// Update replaces the file content when the local source changed, then updates the file fields.
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fileID := plan.ID.ValueString()
+
+	var response struct {
+		Data fileAPIResponse `json:"data"`
+	}
+
+	if !plan.Source.IsNull() && fileContentChanged(state, plan) {
+		if err := r.uploadSource(ctx, fileID, plan.Source.ValueString(), &response); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Replacing File",
+				fmt.Sprintf("Could not replace content of file %s: %s", fileID, err.Error()),
+			)
+			return
+		}
+	}
+
+	input := buildFileInput(ctx, plan, false)
+
+	// The metadata object is replaced as a whole, so the configured keys are merged into
+	// the current metadata, including metadata extracted from a replaced file.
+	if !plan.Metadata.IsUnknown() && !(plan.Metadata.IsNull() && state.Metadata.IsNull()) {
+		if response.Data.ID == "" {
+			if err := r.client.Get(ctx, "files", fileID, &response); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading File",
+					fmt.Sprintf("Could not read file %s: %s", fileID, err.Error()),
+				)
+				return
+			}
+		}
+		input["metadata"] = mergeFileMetadata(response.Data.Metadata, plan.Metadata, state.Metadata)
+	}
+
+	if err := r.client.Update(ctx, "files", fileID, input, &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating File",
+			fmt.Sprintf("Could not update file %s: %s", fileID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "files", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting File",
+			fmt.Sprintf("Could not delete file %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports a file by ID. The local source is not known after import,
+// so source must be added to the configuration to manage the file content.
+func (r *FileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// uploadSource uploads the local file at sourcePath, creating a new file when fileID is empty.
+func (r *FileResource) uploadSource(ctx context.Context, fileID, sourcePath string, result interface{}) error {
+	f, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return r.client.UploadFile(ctx, fileID, nil, filepath.Base(sourcePath), f, result)
+}
+
+// hashFile returns the hex-encoded SHA-256 hash of the file at the given path.
+func hashFile(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// fileContentChanged reports whether the planned local file differs from the uploaded one.
+func fileContentChanged(state, plan FileResourceModel) bool {
+	if plan.Source.IsNull() {
+		return false
+	}
+	return !plan.SourceHash.Equal(state.SourceHash) || !plan.Source.Equal(state.Source)
+}
+
+// fileAPIResponse represents the API response for file operations.
+type fileAPIResponse struct {
+	ID               string          `json:"id"`
+	Title            string          `json:"title,omitempty"`
+	Description      string          `json:"description,omitempty"`
+	Folder           string          `json:"folder,omitempty"`
+	Tags             []string        `json:"tags,omitempty"`
+	Metadata         json.RawMessage `json:"metadata,omitempty"`
+	Type             string          `json:"type,omitempty"`
+	Filesize         flexibleInt64   `json:"filesize,omitempty"`
+	FilenameDownload string          `json:"filename_download,omitempty"`
+}
+
+// flexibleInt64 decodes integers that Directus may return either as JSON numbers
+// or as strings (bigint columns on some database vendors).
+type flexibleInt64 int64
+
+func (f *flexibleInt64) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s: %w", string(data), err)
+	}
+	*f = flexibleInt64(n)
+	return nil
+}
+
+// toModel converts fileAPIResponse to FileResourceModel. Attributes that only exist
+// in Terraform (source, source_hash, url) are carried over from prior.
+func (f *fileAPIResponse) toModel(prior FileResourceModel) *FileResourceModel {
+	model := &FileResourceModel{
+		ID:               types.StringValue(f.ID),
+		Source:           prior.Source,
+		SourceHash:       prior.SourceHash,
+		URL:              prior.URL,
+		Title:            stringOrNull(f.Title),
+		Description:      stringOrNull(f.Description),
+		Folder:           stringOrNull(f.Folder),
+		Tags:             stringListOrNull(f.Tags),
+		Metadata:         types.StringNull(),
+		Type:             stringOrNull(f.Type),
+		Filesize:         types.Int64Value(int64(f.Filesize)),
+		FilenameDownload: stringOrNull(f.FilenameDownload),
+	}
+
+	// Only track metadata keys the user configured.
+	if !prior.Metadata.IsNull() && !prior.Metadata.IsUnknown() {
+		model.Metadata = jsonStringValue(prior.Metadata, projectJSONKeys(prior.Metadata.ValueString(), f.Metadata))
+	}
+
+	return model
+}
+
+// mergeFileMetadata merges the configured metadata keys into the current metadata of a
+// file, so that metadata Directus extracted from the file itself (e.g. EXIF) is kept.
+// Keys that were configured in prior but are no longer configured are removed. Metadata
+// that is not a JSON object replaces the current metadata.
+func mergeFileMetadata(current json.RawMessage, configured, prior types.String) interface{} {
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(current, &merged); err != nil || merged == nil {
+		merged = make(map[string]json.RawMessage)
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorObj map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(prior.ValueString()), &priorObj); err == nil {
+			for key := range priorObj {
+				delete(merged, key)
+			}
+		}
+	}
+
+	if !configured.IsNull() && !configured.IsUnknown() {
+		var configuredObj map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(configured.ValueString()), &configuredObj); err != nil {
+			var decoded interface{}
+			_ = json.Unmarshal([]byte(configured.ValueString()), &decoded)
+			return decoded
+		}
+		for key, value := range configuredObj {
+			merged[key] = value
+		}
+	}
+
+	return merged
+}
+
+// buildFileInput constructs the JSON file fields from the resource model.
+func buildFileInput(ctx context.Context, data FileResourceModel, isCreate bool) map[string]interface{} {
+	input := make(map[string]interface{})
+
+	setStringField(input, "title", data.Title)
+	setJSONField(input, "metadata", data.Metadata)
+
+	if isCreate {
+		setStringField(input, "description", data.Description)
+		setStringField(input, "folder", data.Folder)
+	} else {
+		setNullableStringField(input, "description", data.Description)
+		setNullableStringField(input, "folder", data.Folder)
+	}
+
+	if !data.Tags.IsUnknown() {
+		if data.Tags.IsNull() {
+			if !isCreate {
+				input["tags"] = nil
+			}
+		} else {
+			var tags []string
+			data.Tags.ElementsAs(ctx, &tags, false)
+			input["tags"] = tags
+		}
+	}
+
+	return input
+}
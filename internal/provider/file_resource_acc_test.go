@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFile_upload(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "acctest.txt")
+	if err := os.WriteFile(source, []byte("version 1"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := testAccProviderConfig() + fmt.Sprintf(`
+resource "directus_file" "test" {
+  source = %q
+  title  = "AccTest File"
+  tags   = ["acctest"]
+}
+`, source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_file", "files"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_file.test", "id"),
+					resource.TestCheckResourceAttrSet("directus_file.test", "source_hash"),
+					resource.TestCheckResourceAttr("directus_file.test", "title", "AccTest File"),
+					resource.TestCheckResourceAttr("directus_file.test", "filesize", "9"),
+				),
+			},
+			// Changing the local content replaces the file in place
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte("version 2 with more bytes"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_file.test", "filesize", "25"),
+				),
+			},
+			{
+				ResourceName:            "directus_file.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "source_hash"},
+			},
+		},
+	})
+}
@@ -0,0 +1,515 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTempFile writes content to a file in a temporary directory and returns its path.
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	return p
+}
+
+// nullFileModel returns a FileResourceModel with every attribute null.
+func nullFileModel() FileResourceModel {
+	return FileResourceModel{
+		ID:               types.StringNull(),
+		Source:           types.StringNull(),
+		SourceHash:       types.StringNull(),
+		URL:              types.StringNull(),
+		Title:            types.StringNull(),
+		Description:      types.StringNull(),
+		Folder:           types.StringNull(),
+		Tags:             types.ListNull(types.StringType),
+		Metadata:         types.StringNull(),
+		Type:             types.StringNull(),
+		Filesize:         types.Int64Null(),
+		FilenameDownload: types.StringNull(),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestFileResourceSchema(t *testing.T) {
+	r := &FileResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	expectedAttrs := []string{
+		"id", "source", "source_hash", "url", "title", "description", "folder",
+		"tags", "metadata", "type", "filesize", "filename_download",
+	}
+	for _, attr := range expectedAttrs {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+}
+
+func TestFileResourceMetadata(t *testing.T) {
+	r := &FileResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_file", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestFileResource_ValidateConfig(t *testing.T) {
+	r := &FileResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		mutate    func(m *FileResourceModel)
+		expectErr bool
+	}{
+		{"source only", func(m *FileResourceModel) { m.Source = types.StringValue("logo.svg") }, false},
+		{"url only", func(m *FileResourceModel) { m.URL = types.StringValue("https://example.com/a.png") }, false},
+		{"both", func(m *FileResourceModel) {
+			m.Source = types.StringValue("logo.svg")
+			m.URL = types.StringValue("https://example.com/a.png")
+		}, true},
+		{"neither", func(m *FileResourceModel) {}, true},
+		{"invalid metadata", func(m *FileResourceModel) {
+			m.Source = types.StringValue("logo.svg")
+			m.Metadata = types.StringValue("{")
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := nullFileModel()
+			tt.mutate(&model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, &model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ModifyPlan
+// ---------------------------------------------------------------------------
+
+func TestFileResource_ModifyPlan(t *testing.T) {
+	r := &FileResource{}
+	schema := getResourceSchema(t, r)
+	source := writeTempFile(t, "logo.svg", "<svg/>")
+	expectedHash, err := hashFile(source)
+	require.NoError(t, err)
+
+	t.Run("computes hash on create", func(t *testing.T) {
+		planModel := nullFileModel()
+		planModel.ID = types.StringUnknown()
+		planModel.Source = types.StringValue(source)
+		planModel.SourceHash = types.StringUnknown()
+
+		plan := makePlan(t, schema, &planModel)
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+			Plan:  plan,
+			State: makeNullState(t, schema),
+		}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan diagnostics: %v", resp.Diagnostics)
+
+		var result FileResourceModel
+		resp.Plan.Get(context.Background(), &result)
+		assert.Equal(t, expectedHash, result.SourceHash.ValueString())
+	})
+
+	t.Run("content change marks derived attributes unknown", func(t *testing.T) {
+		stateModel := nullFileModel()
+		stateModel.ID = types.StringValue("file-1")
+		stateModel.Source = types.StringValue(source)
+		stateModel.SourceHash = types.StringValue("old-hash")
+		stateModel.Type = types.StringValue("image/svg+xml")
+		stateModel.Filesize = types.Int64Value(10)
+		stateModel.FilenameDownload = types.StringValue("logo.svg")
+
+		plan := makePlan(t, schema, &stateModel)
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+			Plan:  plan,
+			State: makeState(t, schema, &stateModel),
+		}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan diagnostics: %v", resp.Diagnostics)
+
+		var result FileResourceModel
+		resp.Plan.Get(context.Background(), &result)
+		assert.Equal(t, expectedHash, result.SourceHash.ValueString())
+		assert.True(t, result.Filesize.IsUnknown())
+		assert.True(t, result.Type.IsUnknown())
+	})
+
+	t.Run("missing source file", func(t *testing.T) {
+		planModel := nullFileModel()
+		planModel.Source = types.StringValue(filepath.Join(t.TempDir(), "missing.pdf"))
+
+		plan := makePlan(t, schema, &planModel)
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+			Plan:  plan,
+			State: makeNullState(t, schema),
+		}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+// ---------------------------------------------------------------------------
+// buildFileInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildFileInput(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		model := nullFileModel()
+		model.Title = types.StringValue("Logo")
+		model.Folder = types.StringValue("folder-1")
+		model.Tags = stringListOrNull([]string{"brand"})
+		model.Metadata = types.StringValue(`{"author": "Design"}`)
+
+		input := buildFileInput(context.Background(), model, true)
+
+		assert.Equal(t, "Logo", input["title"])
+		assert.Equal(t, "folder-1", input["folder"])
+		assert.Equal(t, []string{"brand"}, input["tags"])
+		assert.Equal(t, map[string]interface{}{"author": "Design"}, input["metadata"])
+		assert.NotContains(t, input, "description")
+	})
+
+	t.Run("update clears removed fields", func(t *testing.T) {
+		input := buildFileInput(context.Background(), nullFileModel(), false)
+
+		assert.Contains(t, input, "folder")
+		assert.Nil(t, input["folder"])
+		assert.Contains(t, input, "tags")
+		assert.Nil(t, input["tags"])
+		assert.NotContains(t, input, "metadata")
+	})
+}
+
+func TestFileAPIResponseToModel(t *testing.T) {
+	var resp fileAPIResponse
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "file-1", "title": "Logo", "type": "image/png", "filesize": "2048",
+		"filename_download": "logo.png", "tags": ["brand"],
+		"metadata": {"author": "Design", "exif": {"iso": 100}}
+	}`), &resp))
+
+	prior := nullFileModel()
+	prior.Source = types.StringValue("logo.png")
+	prior.SourceHash = types.StringValue("abc")
+	prior.Metadata = types.StringValue(`{"author": "Design"}`)
+
+	model := resp.toModel(prior)
+
+	assert.Equal(t, "file-1", model.ID.ValueString())
+	assert.Equal(t, "logo.png", model.Source.ValueString())
+	assert.Equal(t, "abc", model.SourceHash.ValueString())
+	assert.Equal(t, int64(2048), model.Filesize.ValueInt64())
+	assert.Equal(t, `{"author": "Design"}`, model.Metadata.ValueString())
+
+	// Unmanaged metadata stays null.
+	model = resp.toModel(nullFileModel())
+	assert.True(t, model.Metadata.IsNull())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestFileResource_Create_Upload(t *testing.T) {
+	source := writeTempFile(t, "logo.svg", "<svg/>")
+	var requests []string
+
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method {
+		case "POST":
+			assert.Equal(t, "/files", req.URL.Path)
+			assert.True(t, strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data"))
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{"id": "file-1", "title": "Logo", "type": "image/svg+xml", "filesize": 6},
+			}), nil
+		default:
+			assert.Equal(t, "/files/file-1", req.URL.Path)
+			var body map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, "folder-1", body["folder"])
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{
+					"id": "file-1", "title": "Logo", "type": "image/svg+xml", "filesize": 6,
+					"folder": "folder-1", "filename_download": "logo.svg",
+				},
+			}), nil
+		}
+	})
+
+	r := &FileResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	planModel := nullFileModel()
+	planModel.ID = types.StringUnknown()
+	planModel.Source = types.StringValue(source)
+	planModel.SourceHash = types.StringValue("hash")
+	planModel.Title = types.StringUnknown()
+	planModel.Folder = types.StringValue("folder-1")
+	planModel.Type = types.StringUnknown()
+	planModel.Filesize = types.Int64Unknown()
+	planModel.FilenameDownload = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, &planModel)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []string{"POST /files", "PATCH /files/file-1"}, requests)
+
+	var result FileResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "file-1", result.ID.ValueString())
+	assert.Equal(t, "hash", result.SourceHash.ValueString())
+	assert.Equal(t, "folder-1", result.Folder.ValueString())
+}
+
+func TestFileResource_Create_Upload_KeepsExtractedMetadata(t *testing.T) {
+	source := writeTempFile(t, "photo.jpg", "jpeg")
+	exif := map[string]interface{}{"exif": map[string]interface{}{"ISO": float64(100)}}
+
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method == "POST" {
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{"id": "file-1", "type": "image/jpeg", "metadata": exif},
+			}), nil
+		}
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, map[string]interface{}{"exif": exif["exif"], "author": "Design"}, body["metadata"],
+			"extracted metadata must be kept")
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "file-1", "type": "image/jpeg", "metadata": body["metadata"]},
+		}), nil
+	})
+
+	r := &FileResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	planModel := nullFileModel()
+	planModel.ID = types.StringUnknown()
+	planModel.Source = types.StringValue(source)
+	planModel.SourceHash = types.StringValue("hash")
+	planModel.Metadata = types.StringValue(`{"author":"Design"}`)
+	planModel.Type = types.StringUnknown()
+	planModel.Filesize = types.Int64Unknown()
+	planModel.FilenameDownload = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, &planModel)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result FileResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.JSONEq(t, `{"author":"Design"}`, result.Metadata.ValueString())
+}
+
+func TestFileResource_Create_Import(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/files/import", req.URL.Path)
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "https://example.com/terms.pdf", body["url"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "file-2", "title": "Terms", "type": "application/pdf", "filesize": 100},
+		}), nil
+	})
+
+	r := &FileResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	planModel := nullFileModel()
+	planModel.ID = types.StringUnknown()
+	planModel.URL = types.StringValue("https://example.com/terms.pdf")
+	planModel.Title = types.StringValue("Terms")
+	planModel.Type = types.StringUnknown()
+	planModel.Filesize = types.Int64Unknown()
+	planModel.FilenameDownload = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, &planModel)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result FileResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "file-2", result.ID.ValueString())
+	assert.Equal(t, int64(100), result.Filesize.ValueInt64())
+}
+
+func TestFileResource_Update_ReplacesContent(t *testing.T) {
+	source := writeTempFile(t, "logo.svg", "<svg>v2</svg>")
+	var requests []string
+
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+strings.Split(req.Header.Get("Content-Type"), "/")[0])
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "file-1", "title": "Logo", "type": "image/svg+xml", "filesize": 13},
+		}), nil
+	})
+
+	r := &FileResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	stateModel := nullFileModel()
+	stateModel.ID = types.StringValue("file-1")
+	stateModel.Source = types.StringValue(source)
+	stateModel.SourceHash = types.StringValue("old-hash")
+	stateModel.Title = types.StringValue("Logo")
+
+	planModel := stateModel
+	planModel.SourceHash = types.StringValue("new-hash")
+	planModel.Type = types.StringUnknown()
+	planModel.Filesize = types.Int64Unknown()
+	planModel.FilenameDownload = types.StringUnknown()
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{
+		Plan:  makePlan(t, schema, &planModel),
+		State: makeState(t, schema, &stateModel),
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []string{"PATCH multipart", "PATCH application"}, requests)
+}
+
+func TestFileResource_Update_FieldsOnly(t *testing.T) {
+	source := writeTempFile(t, "logo.svg", "<svg/>")
+	calls := 0
+
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		calls++
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "file-1", "title": "New Title"},
+		}), nil
+	})
+
+	r := &FileResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	stateModel := nullFileModel()
+	stateModel.ID = types.StringValue("file-1")
+	stateModel.Source = types.StringValue(source)
+	stateModel.SourceHash = types.StringValue("same-hash")
+	stateModel.Title = types.StringValue("Old Title")
+
+	planModel := stateModel
+	planModel.Title = types.StringValue("New Title")
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{
+		Plan:  makePlan(t, schema, &planModel),
+		State: makeState(t, schema, &stateModel),
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, 1, calls)
+}
+
+func TestFileResource_Update_MergesMetadata(t *testing.T) {
+	source := writeTempFile(t, "photo.jpg", "jpeg")
+	var requests []string
+
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method)
+		if req.Method == "GET" {
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":       "file-1",
+					"metadata": map[string]interface{}{"author": "Design", "reviewed": true, "width": float64(640)},
+				},
+			}), nil
+		}
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, map[string]interface{}{"author": "Marketing", "width": float64(640)}, body["metadata"],
+			"extracted metadata must be kept and keys no longer configured removed")
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "file-1", "metadata": body["metadata"]},
+		}), nil
+	})
+
+	r := &FileResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	stateModel := nullFileModel()
+	stateModel.ID = types.StringValue("file-1")
+	stateModel.Source = types.StringValue(source)
+	stateModel.SourceHash = types.StringValue("same-hash")
+	stateModel.Metadata = types.StringValue(`{"author":"Design","reviewed":true}`)
+
+	planModel := stateModel
+	planModel.Metadata = types.StringValue(`{"author":"Marketing"}`)
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{
+		Plan:  makePlan(t, schema, &planModel),
+		State: makeState(t, schema, &stateModel),
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []string{"GET", "PATCH"}, requests)
+}
+
+func TestFileResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/files/file-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &FileResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	stateModel := nullFileModel()
+	stateModel.ID = types.StringValue("file-1")
+	state := makeState(t, schema, &stateModel)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
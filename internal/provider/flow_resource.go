@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &FlowResource{}
+	_ resource.ResourceWithConfigure      = &FlowResource{}
+	_ resource.ResourceWithImportState    = &FlowResource{}
+	_ resource.ResourceWithValidateConfig = &FlowResource{}
+)
+
+// NewFlowResource creates a new flow resource.
+func NewFlowResource() resource.Resource {
+	return &FlowResource{}
+}
+
+// FlowResource defines the resource implementation.
+type FlowResource struct {
+	client *client.Client
+}
+
+// FlowResourceModel describes the resource data model.
+// The entry operation is managed from directus_operation (entrypoint = true) to avoid
+// a dependency cycle between the flow and its operations.
+type FlowResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Icon           types.String `tfsdk:"icon"`
+	Color          types.String `tfsdk:"color"`
+	Description    types.String `tfsdk:"description"`
+	Status         types.String `tfsdk:"status"`
+	Trigger        types.String `tfsdk:"trigger"`
+	Options        types.String `tfsdk:"options"`
+	Accountability types.String `tfsdk:"accountability"`
+	Operation      types.String `tfsdk:"operation"`
+}
+
+func (r *FlowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow"
+}
+
+func (r *FlowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Flow resource. Flows are event-driven automations made of a trigger and a chain of operations. " +
+			"Operations are managed with `directus_operation`; the first operation of the flow is selected by setting " +
+			"`entrypoint = true` on that operation.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the flow (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the flow.",
+				Required:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "The name of a Google Material Design Icon assigned to this flow.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "A color hex code for the flow icon (e.g., #6644FF).",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the flow.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the flow is `active` or `inactive`. Defaults to `active`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("active"),
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "The trigger type: `event`, `webhook`, `schedule`, `operation` or `manual`.",
+				Required:            true,
+			},
+			"options": schema.StringAttribute{
+				MarkdownDescription: "JSON object of trigger options, e.g. the event scope, webhook method or cron schedule.",
+				Optional:            true,
+			},
+			"accountability": schema.StringAttribute{
+				MarkdownDescription: "What activity is tracked for the flow: `all` (activity and revisions) or `activity`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the first operation executed by the flow. Set through `directus_operation.entrypoint`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the enumerated attributes and the options JSON.
+func (r *FlowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FlowResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateStringOneOf(data.Status, "active", "inactive"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("status"), "Invalid Flow Status", err.Error())
+	}
+	if err := validateStringOneOf(data.Trigger, "event", "webhook", "schedule", "operation", "manual"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("trigger"), "Invalid Flow Trigger", err.Error())
+	}
+	if err := validateStringOneOf(data.Accountability, "all", "activity"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("accountability"), "Invalid Flow Accountability", err.Error())
+	}
+	if err := validateJSONString(data.Options); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("options"), "Invalid Flow Options", err.Error())
+	}
+}
+
+func (r *FlowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FlowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data flowAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "flows", buildFlowInput(plan, true), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Flow",
+			"Could not create flow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FlowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data flowAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "flows", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Flow",
+			"Could not read flow ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(state))...)
+}
+
+func (r *FlowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FlowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data flowAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "flows", plan.ID.ValueString(), buildFlowInput(plan, false), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Flow",
+			"Could not update flow ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+// Delete removes the flow. Directus deletes the flow's remaining operations along with it.
+func (r *FlowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FlowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "flows", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Flow",
+			"Could not delete flow ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *FlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// flowAPIResponse represents the API response for flow operations.
+type flowAPIResponse struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	Icon           string          `json:"icon,omitempty"`
+	Color          string          `json:"color,omitempty"`
+	Description    string          `json:"description,omitempty"`
+	Status         string          `json:"status,omitempty"`
+	Trigger        string          `json:"trigger,omitempty"`
+	Options        json.RawMessage `json:"options,omitempty"`
+	Accountability string          `json:"accountability,omitempty"`
+	Operation      string          `json:"operation,omitempty"`
+}
+
+// toModel converts flowAPIResponse to FlowResourceModel, keeping the prior
+// options string when it is semantically equal to the API value.
+func (f *flowAPIResponse) toModel(prior FlowResourceModel) *FlowResourceModel {
+	return &FlowResourceModel{
+		ID:             types.StringValue(f.ID),
+		Name:           types.StringValue(f.Name),
+		Icon:           stringOrNull(f.Icon),
+		Color:          stringOrNull(f.Color),
+		Description:    stringOrNull(f.Description),
+		Status:         stringOrNull(f.Status),
+		Trigger:        stringOrNull(f.Trigger),
+		Options:        jsonObjectValue(prior.Options, f.Options),
+		Accountability: stringOrNull(f.Accountability),
+		Operation:      stringOrNull(f.Operation),
+	}
+}
+
+// buildFlowInput constructs the input from the resource model (used for both create and update)
+func buildFlowInput(data FlowResourceModel, isCreate bool) map[string]interface{} {
+	input := map[string]interface{}{
+		"name":    data.Name.ValueString(),
+		"trigger": data.Trigger.ValueString(),
+	}
+
+	setStringField(input, "icon", data.Icon)
+	setStringField(input, "status", data.Status)
+	setStringField(input, "accountability", data.Accountability)
+	setJSONField(input, "options", data.Options)
+
+	if isCreate {
+		setStringField(input, "color", data.Color)
+		setStringField(input, "description", data.Description)
+	} else {
+		setNullableStringField(input, "color", data.Color)
+		setNullableStringField(input, "description", data.Description)
+		if data.Options.IsNull() {
+			input["options"] = nil
+		}
+	}
+
+	return input
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFlow_withOperations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_flow", "flows"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_flow" "test" {
+  name    = "AccTest Flow"
+  trigger = "manual"
+
+  options = jsonencode({
+    collections = ["directus_users"]
+  })
+}
+
+resource "directus_operation" "done" {
+  flow = directus_flow.test.id
+  key  = "done"
+  type = "log"
+
+  options = jsonencode({ message = "done" })
+}
+
+resource "directus_operation" "start" {
+  flow       = directus_flow.test.id
+  name       = "Start"
+  key        = "start"
+  type       = "log"
+  entrypoint = true
+  resolve    = directus_operation.done.id
+
+  options = jsonencode({ message = "start" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_flow.test", "id"),
+					resource.TestCheckResourceAttr("directus_flow.test", "status", "active"),
+					resource.TestCheckResourceAttr("directus_operation.start", "entrypoint", "true"),
+					resource.TestCheckResourceAttr("directus_operation.done", "entrypoint", "false"),
+					resource.TestCheckResourceAttrPair("directus_operation.start", "resolve", "directus_operation.done", "id"),
+				),
+			},
+			// Refresh the flow so the computed entry operation is visible, then deactivate it
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_flow" "test" {
+  name    = "AccTest Flow"
+  trigger = "manual"
+  status  = "inactive"
+
+  options = jsonencode({
+    collections = ["directus_users"]
+  })
+}
+
+resource "directus_operation" "done" {
+  flow = directus_flow.test.id
+  key  = "done"
+  type = "log"
+
+  options = jsonencode({ message = "done" })
+}
+
+resource "directus_operation" "start" {
+  flow       = directus_flow.test.id
+  name       = "Start"
+  key        = "start"
+  type       = "log"
+  entrypoint = true
+  resolve    = directus_operation.done.id
+
+  options = jsonencode({ message = "start" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_flow.test", "status", "inactive"),
+					resource.TestCheckResourceAttrPair("directus_flow.test", "operation", "directus_operation.start", "id"),
+				),
+			},
+			{
+				ResourceName:      "directus_flow.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "directus_operation.start",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
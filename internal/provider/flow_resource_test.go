@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestFlowResourceSchema(t *testing.T) {
+	r := &FlowResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "name", "icon", "color", "description", "status", "trigger", "options", "accountability", "operation"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["trigger"].IsRequired())
+	assert.True(t, schemaResp.Schema.Attributes["operation"].IsComputed())
+	assert.False(t, schemaResp.Schema.Attributes["operation"].IsOptional())
+}
+
+func TestFlowResourceMetadata(t *testing.T) {
+	r := &FlowResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_flow", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newFlowModel() *FlowResourceModel {
+	return &FlowResourceModel{
+		ID:             types.StringNull(),
+		Name:           types.StringValue("Notify"),
+		Icon:           types.StringNull(),
+		Color:          types.StringNull(),
+		Description:    types.StringNull(),
+		Status:         types.StringNull(),
+		Trigger:        types.StringValue("event"),
+		Options:        types.StringNull(),
+		Accountability: types.StringNull(),
+		Operation:      types.StringNull(),
+	}
+}
+
+func TestFlowResource_ValidateConfig(t *testing.T) {
+	r := &FlowResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *FlowResourceModel)
+		expectErr bool
+	}{
+		{"valid", func(m *FlowResourceModel) {}, false},
+		{"valid options", func(m *FlowResourceModel) {
+			m.Options = types.StringValue(`{"type":"action","scope":["items.create"]}`)
+		}, false},
+		{"invalid trigger", func(m *FlowResourceModel) { m.Trigger = types.StringValue("cron") }, true},
+		{"invalid status", func(m *FlowResourceModel) { m.Status = types.StringValue("enabled") }, true},
+		{"invalid accountability", func(m *FlowResourceModel) { m.Accountability = types.StringValue("none") }, true},
+		{"invalid options", func(m *FlowResourceModel) { m.Options = types.StringValue("{not json") }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newFlowModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildFlowInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildFlowInput(t *testing.T) {
+	t.Run("create omits null fields", func(t *testing.T) {
+		model := newFlowModel()
+		model.Status = types.StringValue("active")
+		model.Options = types.StringValue(`{"type":"action"}`)
+
+		input := buildFlowInput(*model, true)
+
+		assert.Equal(t, "Notify", input["name"])
+		assert.Equal(t, "event", input["trigger"])
+		assert.Equal(t, "active", input["status"])
+		assert.Equal(t, map[string]interface{}{"type": "action"}, input["options"])
+		assert.NotContains(t, input, "color")
+		assert.NotContains(t, input, "description")
+		assert.NotContains(t, input, "operation")
+	})
+
+	t.Run("update clears removed fields", func(t *testing.T) {
+		input := buildFlowInput(*newFlowModel(), false)
+
+		assert.Contains(t, input, "color")
+		assert.Nil(t, input["color"])
+		assert.Contains(t, input, "description")
+		assert.Nil(t, input["description"])
+		assert.Contains(t, input, "options")
+		assert.Nil(t, input["options"])
+	})
+}
+
+func TestFlowAPIResponseToModel(t *testing.T) {
+	prior := newFlowModel()
+	prior.Options = types.StringValue(`{ "type": "action" }`)
+
+	model := (&flowAPIResponse{
+		ID:             "flow-1",
+		Name:           "Notify",
+		Icon:           "bolt",
+		Status:         "active",
+		Trigger:        "event",
+		Options:        json.RawMessage(`{"type":"action"}`),
+		Accountability: "all",
+		Operation:      "op-1",
+	}).toModel(*prior)
+
+	assert.Equal(t, "flow-1", model.ID.ValueString())
+	assert.Equal(t, "bolt", model.Icon.ValueString())
+	assert.True(t, model.Color.IsNull())
+	assert.Equal(t, `{ "type": "action" }`, model.Options.ValueString(), "semantically equal options should keep the prior value")
+	assert.Equal(t, "all", model.Accountability.ValueString())
+	assert.Equal(t, "op-1", model.Operation.ValueString())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestFlowResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/flows", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "Notify", body["name"])
+		assert.Equal(t, "webhook", body["trigger"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "flow-1", "name": "Notify", "icon": "bolt", "status": "active",
+				"trigger": "webhook", "options": map[string]interface{}{"method": "POST"},
+				"accountability": "all", "operation": nil,
+			},
+		}), nil
+	})
+
+	r := &FlowResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newFlowModel()
+	model.ID = types.StringUnknown()
+	model.Icon = types.StringUnknown()
+	model.Status = types.StringValue("active")
+	model.Trigger = types.StringValue("webhook")
+	model.Options = types.StringValue(`{"method":"POST"}`)
+	model.Accountability = types.StringUnknown()
+	model.Operation = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result FlowResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "flow-1", result.ID.ValueString())
+	assert.Equal(t, "bolt", result.Icon.ValueString())
+	assert.Equal(t, "all", result.Accountability.ValueString())
+	assert.True(t, result.Operation.IsNull())
+	assert.Equal(t, `{"method":"POST"}`, result.Options.ValueString())
+}
+
+func TestFlowResource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "http://example.com/flows/flow-1", req.URL.String())
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "flow-1", "name": "Notify", "icon": "bolt", "status": "inactive",
+				"trigger": "manual", "options": map[string]interface{}{}, "accountability": "activity",
+				"operation": "op-1",
+			},
+		}), nil
+	})
+
+	r := &FlowResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newFlowModel()
+	model.ID = types.StringValue("flow-1")
+
+	resp := &fwresource.ReadResponse{State: makeState(t, schema, model)}
+	r.Read(context.Background(), fwresource.ReadRequest{State: makeState(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result FlowResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "inactive", result.Status.ValueString())
+	assert.Equal(t, "manual", result.Trigger.ValueString())
+	assert.True(t, result.Options.IsNull(), "empty options should stay null when not configured")
+	assert.Equal(t, "op-1", result.Operation.ValueString())
+}
+
+func TestFlowResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/flows/flow-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &FlowResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newFlowModel()
+	model.ID = types.StringValue("flow-1")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
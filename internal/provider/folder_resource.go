@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                = &FolderResource{}
+	_ resource.ResourceWithConfigure   = &FolderResource{}
+	_ resource.ResourceWithImportState = &FolderResource{}
+)
+
+// NewFolderResource creates a new folder resource.
+func NewFolderResource() resource.Resource {
+	return &FolderResource{}
+}
+
+// FolderResource defines the resource implementation.
+type FolderResource struct {
+	client *client.Client
+}
+
+// FolderResourceModel describes the resource data model.
+type FolderResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Parent types.String `tfsdk:"parent"`
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Folder resource. Folders are virtual and used to organize files in the File Library. " +
+			"Nested folders are created by referencing the `id` of another `directus_folder` in `parent`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the folder (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the folder.",
+				Required:            true,
+			},
+			"parent": schema.StringAttribute{
+				MarkdownDescription: "The ID of the parent folder. Leave empty to create a root-level folder.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data folderAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "folders", buildFolderInput(plan, true), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Folder",
+			"Could not create folder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+// Read refreshes the folder from the API. A changed parent indicates the folder
+// was moved outside of Terraform and will be planned back to the configured parent.
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FolderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data folderAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "folders", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Folder",
+			"Could not read folder ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data folderAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "folders", plan.ID.ValueString(), buildFolderInput(plan, false), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Folder",
+			"Could not update folder ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FolderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "folders", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Folder",
+			"Could not delete folder ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// folderAPIResponse represents the API response for folder operations.
+type folderAPIResponse struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Parent string `json:"parent,omitempty"`
+}
+
+// toModel converts folderAPIResponse to FolderResourceModel
+func (f *folderAPIResponse) toModel() *FolderResourceModel {
+	return &FolderResourceModel{
+		ID:     types.StringValue(f.ID),
+		Name:   types.StringValue(f.Name),
+		Parent: stringOrNull(f.Parent),
+	}
+}
+
+// buildFolderInput constructs the input from the resource model (used for both create and update)
+func buildFolderInput(data FolderResourceModel, isCreate bool) map[string]interface{} {
+	input := map[string]interface{}{
+		"name": data.Name.ValueString(),
+	}
+
+	// Moving a folder back to the root requires explicitly sending null.
+	if isCreate {
+		setStringField(input, "parent", data.Parent)
+	} else {
+		setNullableStringField(input, "parent", data.Parent)
+	}
+
+	return input
+}
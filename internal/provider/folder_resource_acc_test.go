@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFolder_nested(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_folder", "folders"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_folder" "brand" {
+  name = "AccTest Brand"
+}
+
+resource "directus_folder" "logos" {
+  name   = "AccTest Logos"
+  parent = directus_folder.brand.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_folder.brand", "id"),
+					resource.TestCheckNoResourceAttr("directus_folder.brand", "parent"),
+					resource.TestCheckResourceAttrPair("directus_folder.logos", "parent", "directus_folder.brand", "id"),
+				),
+			},
+			// Move the nested folder back to the root
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_folder" "brand" {
+  name = "AccTest Brand"
+}
+
+resource "directus_folder" "logos" {
+  name = "AccTest Logos Renamed"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_folder.logos", "name", "AccTest Logos Renamed"),
+					resource.TestCheckNoResourceAttr("directus_folder.logos", "parent"),
+				),
+			},
+			{
+				ResourceName:      "directus_folder.logos",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestFolderResourceSchema(t *testing.T) {
+	r := &FolderResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "name", "parent"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+}
+
+func TestFolderResourceMetadata(t *testing.T) {
+	r := &FolderResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_folder", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// buildFolderInput
+// ---------------------------------------------------------------------------
+
+func TestBuildFolderInput(t *testing.T) {
+	t.Run("create root folder", func(t *testing.T) {
+		input := buildFolderInput(FolderResourceModel{
+			Name:   types.StringValue("brand"),
+			Parent: types.StringNull(),
+		}, true)
+
+		assert.Equal(t, "brand", input["name"])
+		assert.NotContains(t, input, "parent")
+	})
+
+	t.Run("create nested folder", func(t *testing.T) {
+		input := buildFolderInput(FolderResourceModel{
+			Name:   types.StringValue("logos"),
+			Parent: types.StringValue("folder-brand"),
+		}, true)
+
+		assert.Equal(t, "folder-brand", input["parent"])
+	})
+
+	t.Run("update move to root sends null", func(t *testing.T) {
+		input := buildFolderInput(FolderResourceModel{
+			Name:   types.StringValue("logos"),
+			Parent: types.StringNull(),
+		}, false)
+
+		assert.Contains(t, input, "parent")
+		assert.Nil(t, input["parent"])
+	})
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestFolderResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/folders", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "logos", body["name"])
+		assert.Equal(t, "folder-brand", body["parent"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "folder-logos", "name": "logos", "parent": "folder-brand"},
+		}), nil
+	})
+
+	r := &FolderResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &FolderResourceModel{
+		ID:     types.StringUnknown(),
+		Name:   types.StringValue("logos"),
+		Parent: types.StringValue("folder-brand"),
+	})
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result FolderResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "folder-logos", result.ID.ValueString())
+	assert.Equal(t, "folder-brand", result.Parent.ValueString())
+}
+
+func TestFolderResource_Read_DetectsMove(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "http://example.com/folders/folder-logos", req.URL.String())
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "folder-logos", "name": "logos", "parent": "folder-legal"},
+		}), nil
+	})
+
+	r := &FolderResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &FolderResourceModel{
+		ID:     types.StringValue("folder-logos"),
+		Name:   types.StringValue("logos"),
+		Parent: types.StringValue("folder-brand"),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result FolderResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "folder-legal", result.Parent.ValueString())
+}
+
+func TestFolderResource_Read_Error(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return mockErrorResponse(403, "Forbidden"), nil
+	})
+
+	r := &FolderResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &FolderResourceModel{
+		ID:     types.StringValue("missing"),
+		Name:   types.StringValue("logos"),
+		Parent: types.StringNull(),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestFolderResource_Update(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/folders/folder-logos", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Contains(t, body, "parent")
+		assert.Nil(t, body["parent"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "folder-logos", "name": "logos", "parent": nil},
+		}), nil
+	})
+
+	r := &FolderResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &FolderResourceModel{
+		ID:     types.StringValue("folder-logos"),
+		Name:   types.StringValue("logos"),
+		Parent: types.StringNull(),
+	})
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+
+	var result FolderResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.True(t, result.Parent.IsNull())
+}
+
+func TestFolderResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/folders/folder-logos", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &FolderResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &FolderResourceModel{
+		ID:     types.StringValue("folder-logos"),
+		Name:   types.StringValue("logos"),
+		Parent: types.StringNull(),
+	})
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
@@ -1,7 +1,14 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,6 +40,13 @@ func setBoolField(input map[string]interface{}, key string, value types.Bool) {
 	}
 }
 
+// setInt64Field adds an int64 field to the input map if it's not null/unknown
+func setInt64Field(input map[string]interface{}, key string, value types.Int64) {
+	if !value.IsNull() && !value.IsUnknown() {
+		input[key] = value.ValueInt64()
+	}
+}
+
 // stringOrNull converts a plain string to types.String, returning null for empty strings
 func stringOrNull(s string) types.String {
 	if s != "" {
@@ -54,6 +68,177 @@ func stringListOrNull(items []string) types.List {
 	return types.ListNull(types.StringType)
 }
 
+// stringSetOrNull converts a string slice to types.Set, returning null for empty slices
+func stringSetOrNull(items []string) types.Set {
+	if len(items) > 0 {
+		elements := make([]attr.Value, len(items))
+		for i, item := range items {
+			elements[i] = types.StringValue(item)
+		}
+		setValue, _ := types.SetValue(types.StringType, elements)
+		return setValue
+	}
+	return types.SetNull(types.StringType)
+}
+
+// stringSetValues returns the known string elements of a set, or nil if the set is null or unknown
+func stringSetValues(value types.Set) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	var values []string
+	for _, element := range value.Elements() {
+		if s, ok := element.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			values = append(values, s.ValueString())
+		}
+	}
+	return values
+}
+
+// stringListValues returns the known string elements of a list, or nil if the list is null or unknown
+func stringListValues(value types.List) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	var values []string
+	for _, element := range value.Elements() {
+		if s, ok := element.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			values = append(values, s.ValueString())
+		}
+	}
+	return values
+}
+
+// setJSONField decodes a JSON string attribute and adds it to the input map if it's not null/unknown.
+// Invalid JSON is reported at plan time by validateJSONString, so decoding errors are skipped here.
+func setJSONField(input map[string]interface{}, key string, value types.String) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &decoded); err == nil {
+		input[key] = decoded
+	}
+}
+
+// validateJSONString returns an error if the value is set but is not a valid JSON document.
+func validateJSONString(value types.String) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &decoded); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	return nil
+}
+
+// validateJSONObject returns an error if the value is set but is not a JSON object.
+func validateJSONObject(value types.String) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &decoded); err != nil || decoded == nil {
+		return fmt.Errorf("value must be a JSON object, got: %s", value.ValueString())
+	}
+	return nil
+}
+
+// validateStringOneOf returns an error if the value is set but is not one of the allowed values.
+func validateStringOneOf(value types.String, allowed ...string) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	for _, a := range allowed {
+		if value.ValueString() == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("value must be one of %q, got: %q", allowed, value.ValueString())
+}
+
+// normalizeJSON re-encodes a JSON document in compact form with sorted object keys.
+func normalizeJSON(raw []byte) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return "", err
+	}
+
+	normalized, err := json.Marshal(decoded)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal,
+// ignoring whitespace and object key order.
+func jsonEqual(a, b string) bool {
+	na, errA := normalizeJSON([]byte(a))
+	nb, errB := normalizeJSON([]byte(b))
+	return errA == nil && errB == nil && na == nb
+}
+
+// jsonStringValue converts raw JSON returned by the API into a types.String.
+// When the prior value is semantically equal it is kept verbatim, so that
+// formatting differences between the configuration and the API do not cause diffs.
+func jsonStringValue(prior types.String, raw json.RawMessage) types.String {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && jsonEqual(prior.ValueString(), string(raw)) {
+		return prior
+	}
+	normalized, err := normalizeJSON(raw)
+	if err != nil {
+		return types.StringValue(string(raw))
+	}
+	return types.StringValue(normalized)
+}
+
+// jsonObjectValue is like jsonStringValue, but maps an empty JSON object to null when the
+// attribute was not configured, since Directus defaults many JSON columns to {}.
+func jsonObjectValue(prior types.String, raw json.RawMessage) types.String {
+	if prior.IsNull() {
+		if normalized, err := normalizeJSON(raw); err == nil && normalized == "{}" {
+			return types.StringNull()
+		}
+	}
+	return jsonStringValue(prior, raw)
+}
+
+// projectJSONKeys restricts a JSON object returned by the API to the top-level keys
+// present in the prior JSON object. This lets resources manage only the keys a user
+// configured while Directus adds its own (e.g. extracted file metadata).
+// Non-object documents are returned unchanged.
+func projectJSONKeys(prior string, raw json.RawMessage) json.RawMessage {
+	var priorObj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(prior), &priorObj); err != nil {
+		return raw
+	}
+	var rawObj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &rawObj); err != nil {
+		return raw
+	}
+
+	projected := make(map[string]json.RawMessage, len(priorObj))
+	for key := range priorObj {
+		if value, ok := rawObj[key]; ok {
+			projected[key] = value
+		}
+	}
+
+	encoded, err := json.Marshal(projected)
+	if err != nil {
+		return raw
+	}
+	return encoded
+}
+
 // buildInputMap creates a map for API requests from optional fields
 // This is a common pattern for both create and update operations
 func buildInputMap(fields map[string]interface{}) map[string]interface{} {
@@ -66,3 +251,49 @@ func buildInputMap(fields map[string]interface{}) map[string]interface{} {
 	}
 	return input
 }
+
+// listQueryAttributes returns the filter, sort and limit arguments shared by the list data sources.
+func listQueryAttributes(noun string) map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"filter": dschema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("A Directus filter expression as a JSON object, e.g. `jsonencode({ name = { _contains = \"Editor\" } })`. Only %s matching the filter are returned.", noun),
+			Optional:            true,
+		},
+		"sort": dschema.ListAttribute{
+			MarkdownDescription: "Fields to sort by. Prefix a field with `-` to sort in descending order.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"limit": dschema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The maximum number of %s to return. Defaults to all.", noun),
+			Optional:            true,
+		},
+	}
+}
+
+// listQueryParams builds the filter, sort and limit query parameters of a list data source.
+// Without a limit, all matching records are requested.
+func listQueryParams(filter types.String, sort types.List, limit types.Int64) map[string]string {
+	params := map[string]string{"limit": "-1"}
+	if !filter.IsNull() && !filter.IsUnknown() {
+		params["filter"] = filter.ValueString()
+	}
+	if fields := stringListValues(sort); len(fields) > 0 {
+		params["sort"] = strings.Join(fields, ",")
+	}
+	if !limit.IsNull() && !limit.IsUnknown() {
+		params["limit"] = strconv.FormatInt(limit.ValueInt64(), 10)
+	}
+	return params
+}
+
+// validatePositiveInt64 returns an error if the value is set but is not positive.
+func validatePositiveInt64(value types.Int64) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	if value.ValueInt64() < 1 {
+		return fmt.Errorf("value must be at least 1, got: %d", value.ValueInt64())
+	}
+	return nil
+}
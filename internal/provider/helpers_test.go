@@ -4,8 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSetStringField(t *testing.T) {
@@ -60,6 +62,26 @@ func TestSetBoolField(t *testing.T) {
 	})
 }
 
+func TestSetInt64Field(t *testing.T) {
+	t.Run("adds value", func(t *testing.T) {
+		input := make(map[string]interface{})
+		setInt64Field(input, "sort", types.Int64Value(3))
+		assert.Equal(t, int64(3), input["sort"])
+	})
+
+	t.Run("skips null value", func(t *testing.T) {
+		input := make(map[string]interface{})
+		setInt64Field(input, "sort", types.Int64Null())
+		assert.NotContains(t, input, "sort")
+	})
+
+	t.Run("skips unknown value", func(t *testing.T) {
+		input := make(map[string]interface{})
+		setInt64Field(input, "sort", types.Int64Unknown())
+		assert.NotContains(t, input, "sort")
+	})
+}
+
 func TestStringOrNull(t *testing.T) {
 	t.Run("returns value for non-empty string", func(t *testing.T) {
 		result := stringOrNull("hello")
@@ -103,6 +125,23 @@ func TestStringListOrNull(t *testing.T) {
 	})
 }
 
+func TestStringSetOrNull(t *testing.T) {
+	t.Run("returns set for non-empty slice", func(t *testing.T) {
+		result := stringSetOrNull([]string{"a", "b"})
+		assert.False(t, result.IsNull())
+
+		var elements []string
+		result.ElementsAs(context.Background(), &elements, false)
+		assert.ElementsMatch(t, []string{"a", "b"}, elements)
+	})
+
+	t.Run("returns null for empty slice", func(t *testing.T) {
+		result := stringSetOrNull(nil)
+		assert.True(t, result.IsNull())
+		assert.Equal(t, types.StringType, result.ElementType(context.Background()))
+	})
+}
+
 func TestBuildInputMap(t *testing.T) {
 	t.Run("includes non-nil values only", func(t *testing.T) {
 		fields := map[string]interface{}{
@@ -151,3 +190,103 @@ func TestBuildInputMap(t *testing.T) {
 		assert.Equal(t, []string{"a"}, result["list"])
 	})
 }
+
+func TestSetJSONField(t *testing.T) {
+	t.Run("decodes object", func(t *testing.T) {
+		input := make(map[string]interface{})
+		setJSONField(input, "options", types.StringValue(`{"a": 1}`))
+		assert.Equal(t, map[string]interface{}{"a": float64(1)}, input["options"])
+	})
+
+	t.Run("skips null value", func(t *testing.T) {
+		input := make(map[string]interface{})
+		setJSONField(input, "options", types.StringNull())
+		assert.NotContains(t, input, "options")
+	})
+}
+
+func TestValidateJSONString(t *testing.T) {
+	assert.NoError(t, validateJSONString(types.StringValue(`{"a": [1, 2]}`)))
+	assert.NoError(t, validateJSONString(types.StringNull()))
+	assert.NoError(t, validateJSONString(types.StringUnknown()))
+	assert.Error(t, validateJSONString(types.StringValue(`{"a":`)))
+}
+
+func TestValidateStringOneOf(t *testing.T) {
+	assert.NoError(t, validateStringOneOf(types.StringValue("active"), "active", "inactive"))
+	assert.NoError(t, validateStringOneOf(types.StringNull(), "active"))
+	assert.NoError(t, validateStringOneOf(types.StringUnknown(), "active"))
+
+	err := validateStringOneOf(types.StringValue("paused"), "active", "inactive")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "paused")
+}
+
+func TestJSONEqual(t *testing.T) {
+	assert.True(t, jsonEqual(`{"a": 1, "b": [true]}`, `{"b":[true],"a":1}`))
+	assert.False(t, jsonEqual(`{"a": 1}`, `{"a": 2}`))
+	assert.False(t, jsonEqual(`{"a": 1}`, `not json`))
+}
+
+func TestJSONStringValue(t *testing.T) {
+	t.Run("keeps semantically equal prior value", func(t *testing.T) {
+		prior := types.StringValue("{\n  \"a\": 1\n}")
+		result := jsonStringValue(prior, []byte(`{"a":1}`))
+		assert.Equal(t, prior.ValueString(), result.ValueString())
+	})
+
+	t.Run("normalizes changed value", func(t *testing.T) {
+		result := jsonStringValue(types.StringValue(`{"a": 1}`), []byte(`{"b": 2, "a": 3}`))
+		assert.Equal(t, `{"a":3,"b":2}`, result.ValueString())
+	})
+
+	t.Run("null raw value", func(t *testing.T) {
+		assert.True(t, jsonStringValue(types.StringNull(), []byte("null")).IsNull())
+		assert.True(t, jsonStringValue(types.StringNull(), nil).IsNull())
+	})
+}
+
+func TestJSONObjectValue(t *testing.T) {
+	assert.True(t, jsonObjectValue(types.StringNull(), []byte(`{}`)).IsNull())
+	assert.Equal(t, `{}`, jsonObjectValue(types.StringValue(`{}`), []byte(`{}`)).ValueString())
+	assert.Equal(t, `{"a":1}`, jsonObjectValue(types.StringNull(), []byte(`{"a": 1}`)).ValueString())
+}
+
+func TestProjectJSONKeys(t *testing.T) {
+	projected := projectJSONKeys(`{"author": "x"}`, []byte(`{"author": "y", "exif": {"iso": 100}}`))
+	assert.JSONEq(t, `{"author": "y"}`, string(projected))
+
+	projected = projectJSONKeys(`{"missing": 1}`, []byte(`{"other": 2}`))
+	assert.JSONEq(t, `{}`, string(projected))
+
+	projected = projectJSONKeys(`[1]`, []byte(`[2]`))
+	assert.JSONEq(t, `[2]`, string(projected))
+}
+
+func TestValidateJSONObject(t *testing.T) {
+	assert.NoError(t, validateJSONObject(types.StringValue(`{"a": 1}`)))
+	assert.NoError(t, validateJSONObject(types.StringNull()))
+	assert.NoError(t, validateJSONObject(types.StringUnknown()))
+	assert.Error(t, validateJSONObject(types.StringValue(`[1]`)))
+	assert.Error(t, validateJSONObject(types.StringValue(`null`)))
+	assert.Error(t, validateJSONObject(types.StringValue(`{`)))
+}
+
+func TestListQueryParams(t *testing.T) {
+	params := listQueryParams(types.StringNull(), types.ListNull(types.StringType), types.Int64Null())
+	assert.Equal(t, map[string]string{"limit": "-1"}, params)
+
+	sort := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("-name"), types.StringValue("id")})
+	params = listQueryParams(types.StringValue(`{"name": {"_eq": "x"}}`), sort, types.Int64Value(5))
+	assert.Equal(t, map[string]string{
+		"filter": `{"name": {"_eq": "x"}}`,
+		"sort":   "-name,id",
+		"limit":  "5",
+	}, params)
+}
+
+func TestValidatePositiveInt64(t *testing.T) {
+	assert.NoError(t, validatePositiveInt64(types.Int64Value(1)))
+	assert.NoError(t, validatePositiveInt64(types.Int64Null()))
+	assert.Error(t, validatePositiveInt64(types.Int64Value(0)))
+}
@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &ItemResource{}
+	_ resource.ResourceWithConfigure      = &ItemResource{}
+	_ resource.ResourceWithImportState    = &ItemResource{}
+	_ resource.ResourceWithValidateConfig = &ItemResource{}
+)
+
+// NewItemResource creates a new item resource.
+func NewItemResource() resource.Resource {
+	return &ItemResource{}
+}
+
+// ItemResource defines the resource implementation.
+type ItemResource struct {
+	client *client.Client
+}
+
+// ItemResourceModel describes the resource data model.
+type ItemResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Collection      types.String `tfsdk:"collection"`
+	Singleton       types.Bool   `tfsdk:"singleton"`
+	PrimaryKeyField types.String `tfsdk:"primary_key_field"`
+	PrimaryKey      types.String `tfsdk:"primary_key"`
+	Data            types.String `tfsdk:"data"`
+}
+
+func (r *ItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item"
+}
+
+func (r *ItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Item resource. Manages a single item of a custom collection, such as reference data " +
+			"that has to exist in every environment. Only the top-level keys set in `data` are managed; other fields of the item " +
+			"are left untouched and ignored when detecting drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The resource identifier: `<collection>/<primary_key>`, or the collection name for singletons.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				MarkdownDescription: "The name of the collection the item belongs to. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"singleton": schema.BoolAttribute{
+				MarkdownDescription: "Whether the collection is a singleton. The single item is updated in place and left untouched on destroy. " +
+					"Defaults to `false`. Changing this forces a new resource.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_key_field": schema.StringAttribute{
+				MarkdownDescription: "The name of the collection's primary key field. Defaults to `id`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("id"),
+			},
+			"primary_key": schema.StringAttribute{
+				MarkdownDescription: "A fixed primary key for the item. When omitted, Directus generates one. " +
+					"Integer keys are represented as strings and sent as numbers if the primary key field is an integer field. " +
+					"Changing this forces a new resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "JSON object of the item's field values, e.g. `jsonencode({ code = \"DE\", name = \"Germany\" })`. " +
+					"Compared semantically; only the keys present here are managed.",
+				Required: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that data is a JSON object and that singletons have no fixed primary key.
+func (r *ItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ItemResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateJSONObject(data.Data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("data"), "Invalid Item Data", err.Error())
+	}
+
+	if data.Singleton.ValueBool() && !data.PrimaryKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary_key"),
+			"Conflicting Item Configuration",
+			"primary_key cannot be set for singleton collections.",
+		)
+	}
+}
+
+func (r *ItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ItemResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	collection := plan.Collection.ValueString()
+	input := buildItemInput(plan)
+
+	var err error
+	if plan.Singleton.ValueBool() {
+		err = r.client.UpdateSingleton(ctx, collection, input, &response)
+	} else {
+		if !plan.PrimaryKey.IsNull() && !plan.PrimaryKey.IsUnknown() {
+			key, keyErr := r.primaryKeyValue(ctx, collection, plan.PrimaryKeyField.ValueString(), plan.PrimaryKey.ValueString())
+			if keyErr != nil {
+				resp.Diagnostics.AddError(
+					"Error Creating Item",
+					"Could not read the primary key field of collection "+collection+": "+keyErr.Error(),
+				)
+				return
+			}
+			input[plan.PrimaryKeyField.ValueString()] = key
+		}
+		err = r.client.Create(ctx, collection, input, &response)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Item",
+			"Could not create item in collection "+collection+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, itemToModel(plan, response.Data))...)
+}
+
+func (r *ItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ItemResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	collection := state.Collection.ValueString()
+
+	var err error
+	if state.Singleton.ValueBool() {
+		err = r.client.GetSingleton(ctx, collection, &response)
+	} else {
+		err = r.client.Get(ctx, collection, url.PathEscape(state.PrimaryKey.ValueString()), &response)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Item",
+			"Could not read item "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, itemToModel(state, response.Data))...)
+}
+
+func (r *ItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ItemResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	collection := plan.Collection.ValueString()
+	input := buildItemInput(plan)
+
+	var err error
+	if plan.Singleton.ValueBool() {
+		err = r.client.UpdateSingleton(ctx, collection, input, &response)
+	} else {
+		err = r.client.Update(ctx, collection, url.PathEscape(plan.PrimaryKey.ValueString()), input, &response)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Item",
+			"Could not update item "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, itemToModel(plan, response.Data))...)
+}
+
+// Delete removes the item. Singleton items cannot be deleted and are only removed from state.
+func (r *ItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ItemResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Singleton.ValueBool() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, state.Collection.ValueString(), url.PathEscape(state.PrimaryKey.ValueString())); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Item",
+			"Could not delete item "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState accepts <collection>/<primary_key> for regular items and <collection> for singletons.
+// The primary key field of regular items is read from the collection's fields.
+func (r *ItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	collection, primaryKey, hasKey := strings.Cut(req.ID, "/")
+	if collection == "" || (hasKey && primaryKey == "") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format <collection>/<primary_key>, or <collection> for singletons, got: %q", req.ID),
+		)
+		return
+	}
+
+	primaryKeyField := "id"
+	if hasKey {
+		field, err := r.primaryKeyField(ctx, collection)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Item",
+				"Could not read the primary key field of collection "+collection+": "+err.Error(),
+			)
+			return
+		}
+		if field != nil {
+			primaryKeyField = field.Field
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), collection)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("singleton"), !hasKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("primary_key_field"), primaryKeyField)...)
+	if hasKey {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("primary_key"), primaryKey)...)
+	}
+}
+
+// primaryKeyField returns the primary key field of a collection, or nil if it has none.
+func (r *ItemResource) primaryKeyField(ctx context.Context, collection string) (*collectionFieldResponse, error) {
+	var fields struct {
+		Data []collectionFieldResponse `json:"data"`
+	}
+	if err := r.client.Get(ctx, "fields", collection, &fields); err != nil {
+		return nil, err
+	}
+	for i, field := range fields.Data {
+		if field.Schema != nil && field.Schema.IsPrimaryKey {
+			return &fields.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// primaryKeyValue returns a fixed primary key as it is sent to Directus: as a number if it
+// is an integer and the primary key field is an integer field, as a string otherwise.
+// The fields are only read for keys that look like integers.
+func (r *ItemResource) primaryKeyValue(ctx context.Context, collection, fieldName, key string) (interface{}, error) {
+	if _, err := strconv.ParseInt(key, 10, 64); err != nil {
+		return key, nil
+	}
+	field, err := r.primaryKeyField(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	if field != nil && field.Field == fieldName && (field.Type == "integer" || field.Type == "bigInteger") {
+		return json.Number(key), nil
+	}
+	return key, nil
+}
+
+// itemToModel converts an item returned by the API into the resource model. The data
+// attribute is restricted to the keys of the prior data, so fields that are not managed
+// by Terraform never cause a diff. On import, where there is no prior data, all fields are kept.
+func itemToModel(prior ItemResourceModel, raw json.RawMessage) *ItemResourceModel {
+	model := prior
+
+	var fields map[string]json.RawMessage
+	_ = json.Unmarshal(raw, &fields)
+
+	if pk := itemPrimaryKey(fields[prior.PrimaryKeyField.ValueString()]); pk != "" {
+		model.PrimaryKey = types.StringValue(pk)
+	} else if model.PrimaryKey.IsUnknown() {
+		model.PrimaryKey = types.StringNull()
+	}
+
+	if model.Singleton.ValueBool() {
+		model.ID = types.StringValue(prior.Collection.ValueString())
+	} else {
+		model.ID = types.StringValue(prior.Collection.ValueString() + "/" + model.PrimaryKey.ValueString())
+	}
+
+	if prior.Data.IsNull() || prior.Data.IsUnknown() {
+		model.Data = jsonStringValue(types.StringNull(), raw)
+	} else {
+		model.Data = jsonStringValue(prior.Data, projectJSONKeys(prior.Data.ValueString(), raw))
+	}
+
+	return &model
+}
+
+// itemPrimaryKey renders a raw primary key value as a string. String keys are unquoted and
+// numeric keys are kept verbatim; missing or null keys yield an empty string.
+func itemPrimaryKey(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// buildItemInput decodes the data attribute into the request body. Numbers are kept
+// as json.Number so that large integer keys are sent without loss of precision.
+func buildItemInput(data ItemResourceModel) map[string]interface{} {
+	input := make(map[string]interface{})
+	if data.Data.IsNull() || data.Data.IsUnknown() {
+		return input
+	}
+	decoder := json.NewDecoder(strings.NewReader(data.Data.ValueString()))
+	decoder.UseNumber()
+	_ = decoder.Decode(&input)
+	return input
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccItemCollectionConfig = `
+resource "directus_collection" "test" {
+  collection = "acc_test_items"
+}
+`
+
+func TestAccItem_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_collection", "collections"),
+		Steps: []resource.TestStep{
+			// The provider does not manage fields yet, so the name field is added over the API.
+			{
+				Config: testAccProviderConfig() + testAccItemCollectionConfig,
+			},
+			{
+				PreConfig: func() { testAccCreateStringField(t, "acc_test_items", "name") },
+				Config: testAccProviderConfig() + testAccItemCollectionConfig + `
+resource "directus_item" "test" {
+  collection = directus_collection.test.collection
+  data       = jsonencode({ name = "Germany" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_item.test", "primary_key"),
+					resource.TestCheckResourceAttr("directus_item.test", "data", `{"name":"Germany"}`),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccItemCollectionConfig + `
+resource "directus_item" "test" {
+  collection = directus_collection.test.collection
+  data       = jsonencode({ name = "Deutschland" })
+}
+`,
+				Check: resource.TestCheckResourceAttr("directus_item.test", "data", `{"name":"Deutschland"}`),
+			},
+			// Imported items track every field, so data is not compared.
+			{
+				ResourceName:            "directus_item.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data"},
+			},
+		},
+	})
+}
+
+// testAccCreateStringField adds a string field to a collection through the API.
+func testAccCreateStringField(t *testing.T, collection, field string) {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]interface{}{"field": field, "type": "string"})
+	req, err := http.NewRequest("POST", os.Getenv("DIRECTUS_ENDPOINT")+"/fields/"+collection, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create field request: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv("DIRECTUS_TOKEN"))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to create field %s.%s: %s", collection, field, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Directus returned HTTP %d when creating field %s.%s", resp.StatusCode, collection, field)
+	}
+}
@@ -0,0 +1,463 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestItemResourceSchema(t *testing.T) {
+	r := &ItemResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "collection", "singleton", "primary_key_field", "primary_key", "data"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["collection"].IsRequired())
+	assert.True(t, schemaResp.Schema.Attributes["data"].IsRequired())
+}
+
+func TestItemResourceMetadata(t *testing.T) {
+	r := &ItemResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_item", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newItemModel() *ItemResourceModel {
+	return &ItemResourceModel{
+		ID:              types.StringNull(),
+		Collection:      types.StringValue("countries"),
+		Singleton:       types.BoolValue(false),
+		PrimaryKeyField: types.StringValue("id"),
+		PrimaryKey:      types.StringNull(),
+		Data:            types.StringValue(`{"code":"DE","name":"Germany"}`),
+	}
+}
+
+func TestItemResource_ValidateConfig(t *testing.T) {
+	r := &ItemResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *ItemResourceModel)
+		expectErr bool
+	}{
+		{"valid", func(m *ItemResourceModel) {}, false},
+		{"fixed primary key", func(m *ItemResourceModel) { m.PrimaryKey = types.StringValue("DE") }, false},
+		{"singleton", func(m *ItemResourceModel) { m.Singleton = types.BoolValue(true) }, false},
+		{"invalid json", func(m *ItemResourceModel) { m.Data = types.StringValue("{") }, true},
+		{"json array", func(m *ItemResourceModel) { m.Data = types.StringValue(`[1, 2]`) }, true},
+		{"json null", func(m *ItemResourceModel) { m.Data = types.StringValue(`null`) }, true},
+		{"singleton with primary key", func(m *ItemResourceModel) {
+			m.Singleton = types.BoolValue(true)
+			m.PrimaryKey = types.StringValue("1")
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newItemModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// itemToModel / itemPrimaryKey
+// ---------------------------------------------------------------------------
+
+func TestItemPrimaryKey(t *testing.T) {
+	assert.Equal(t, "DE", itemPrimaryKey(json.RawMessage(`"DE"`)))
+	assert.Equal(t, "42", itemPrimaryKey(json.RawMessage(`42`)))
+	assert.Equal(t, "", itemPrimaryKey(json.RawMessage(`null`)))
+	assert.Equal(t, "", itemPrimaryKey(nil))
+}
+
+func TestItemToModel(t *testing.T) {
+	t.Run("projects data onto configured keys", func(t *testing.T) {
+		prior := newItemModel()
+		prior.PrimaryKey = types.StringUnknown()
+		prior.Data = types.StringValue(`{ "name": "Germany", "code": "DE" }`)
+
+		model := itemToModel(*prior, json.RawMessage(`{"id":3,"code":"DE","name":"Germany","date_created":"2024-01-01T00:00:00Z"}`))
+
+		assert.Equal(t, "3", model.PrimaryKey.ValueString())
+		assert.Equal(t, "countries/3", model.ID.ValueString())
+		assert.Equal(t, prior.Data.ValueString(), model.Data.ValueString(), "unmanaged fields should not cause a diff")
+	})
+
+	t.Run("reports drift on configured keys", func(t *testing.T) {
+		prior := newItemModel()
+		prior.PrimaryKey = types.StringValue("3")
+
+		model := itemToModel(*prior, json.RawMessage(`{"id":3,"code":"DE","name":"Deutschland"}`))
+
+		assert.Equal(t, `{"code":"DE","name":"Deutschland"}`, model.Data.ValueString())
+	})
+
+	t.Run("import keeps all fields", func(t *testing.T) {
+		prior := newItemModel()
+		prior.PrimaryKey = types.StringValue("3")
+		prior.Data = types.StringNull()
+
+		model := itemToModel(*prior, json.RawMessage(`{"name":"Germany","id":3}`))
+
+		assert.Equal(t, `{"id":3,"name":"Germany"}`, model.Data.ValueString())
+	})
+
+	t.Run("custom primary key field", func(t *testing.T) {
+		prior := newItemModel()
+		prior.PrimaryKeyField = types.StringValue("code")
+		prior.PrimaryKey = types.StringUnknown()
+
+		model := itemToModel(*prior, json.RawMessage(`{"code":"DE","name":"Germany"}`))
+
+		assert.Equal(t, "DE", model.PrimaryKey.ValueString())
+		assert.Equal(t, "countries/DE", model.ID.ValueString())
+	})
+
+	t.Run("singleton", func(t *testing.T) {
+		prior := newItemModel()
+		prior.Collection = types.StringValue("site")
+		prior.Singleton = types.BoolValue(true)
+		prior.PrimaryKey = types.StringUnknown()
+		prior.Data = types.StringValue(`{"title":"Home"}`)
+
+		model := itemToModel(*prior, json.RawMessage(`{"id":1,"title":"Home"}`))
+
+		assert.Equal(t, "site", model.ID.ValueString())
+		assert.Equal(t, "1", model.PrimaryKey.ValueString())
+	})
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestItemResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/items/countries", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, map[string]interface{}{"code": "DE", "name": "Germany"}, body)
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": 3, "code": "DE", "name": "Germany", "sort": nil},
+		}), nil
+	})
+
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemModel()
+	model.ID = types.StringUnknown()
+	model.PrimaryKey = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result ItemResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "countries/3", result.ID.ValueString())
+	assert.Equal(t, "3", result.PrimaryKey.ValueString())
+	assert.Equal(t, model.Data.ValueString(), result.Data.ValueString())
+}
+
+func TestItemResource_Create_FixedPrimaryKey(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "DE", body["code"])
+		assert.Equal(t, "Germany", body["name"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"code": "DE", "name": "Germany"},
+		}), nil
+	})
+
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemModel()
+	model.ID = types.StringUnknown()
+	model.PrimaryKeyField = types.StringValue("code")
+	model.PrimaryKey = types.StringValue("DE")
+	model.Data = types.StringValue(`{"name":"Germany"}`)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result ItemResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "countries/DE", result.ID.ValueString())
+	assert.Equal(t, `{"name":"Germany"}`, result.Data.ValueString())
+}
+
+func TestItemResource_Create_IntegerPrimaryKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		fieldType string
+		want      interface{}
+	}{
+		{"integer field", "integer", float64(42)},
+		{"string field", "string", "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				if req.Method == "GET" {
+					assert.Equal(t, "/fields/countries", req.URL.Path)
+					return mockJSONResponse(200, map[string]interface{}{
+						"data": []map[string]interface{}{
+							{"field": "id", "type": tt.fieldType, "schema": map[string]interface{}{"is_primary_key": true}},
+						},
+					}), nil
+				}
+
+				var body map[string]interface{}
+				bodyBytes, _ := io.ReadAll(req.Body)
+				json.Unmarshal(bodyBytes, &body)
+				assert.Equal(t, tt.want, body["id"])
+
+				return mockJSONResponse(200, map[string]interface{}{
+					"data": map[string]interface{}{"id": body["id"], "name": "Germany"},
+				}), nil
+			})
+
+			r := &ItemResource{client: mockClient}
+			schema := getResourceSchema(t, r)
+
+			model := newItemModel()
+			model.ID = types.StringUnknown()
+			model.PrimaryKey = types.StringValue("42")
+			model.Data = types.StringValue(`{"name":"Germany"}`)
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+			r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+			var result ItemResourceModel
+			resp.State.Get(context.Background(), &result)
+			assert.Equal(t, "countries/42", result.ID.ValueString())
+		})
+	}
+}
+
+func TestItemResource_Create_Singleton(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/items/site", req.URL.String())
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": 1, "title": "Home", "logo": nil},
+		}), nil
+	})
+
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemModel()
+	model.ID = types.StringUnknown()
+	model.Collection = types.StringValue("site")
+	model.Singleton = types.BoolValue(true)
+	model.PrimaryKey = types.StringUnknown()
+	model.Data = types.StringValue(`{"title":"Home"}`)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result ItemResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "site", result.ID.ValueString())
+}
+
+func TestItemResource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "http://example.com/items/countries/united%20kingdom", req.URL.String())
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "united kingdom", "code": "GB", "name": "United Kingdom"},
+		}), nil
+	})
+
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemModel()
+	model.ID = types.StringValue("countries/united kingdom")
+	model.PrimaryKey = types.StringValue("united kingdom")
+	model.Data = types.StringValue(`{"name":"UK"}`)
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result ItemResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, `{"name":"United Kingdom"}`, result.Data.ValueString())
+}
+
+func TestItemResource_Update(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/items/countries/3", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, map[string]interface{}{"code": "DE", "name": "Deutschland"}, body)
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": 3, "code": "DE", "name": "Deutschland"},
+		}), nil
+	})
+
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemModel()
+	model.ID = types.StringValue("countries/3")
+	model.PrimaryKey = types.StringValue("3")
+	model.Data = types.StringValue(`{"code":"DE","name":"Deutschland"}`)
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+}
+
+func TestItemResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/items/countries/3", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemModel()
+	model.ID = types.StringValue("countries/3")
+	model.PrimaryKey = types.StringValue("3")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
+
+func TestItemResource_Delete_Singleton(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemModel()
+	model.ID = types.StringValue("site")
+	model.Collection = types.StringValue("site")
+	model.Singleton = types.BoolValue(true)
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
+
+// ---------------------------------------------------------------------------
+// ImportState
+// ---------------------------------------------------------------------------
+
+func TestItemResource_ImportState(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/fields/countries", req.URL.Path)
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"field": "name", "type": "string", "schema": map[string]interface{}{"is_primary_key": false}},
+				{"field": "code", "type": "string", "schema": map[string]interface{}{"is_primary_key": true}},
+			},
+		}), nil
+	})
+	r := &ItemResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name                string
+		id                  string
+		expectErr           bool
+		collection          string
+		primaryKey          string
+		wantSingleton       bool
+		wantPrimaryKeyField string
+	}{
+		{"item", "countries/DE", false, "countries", "DE", false, "code"},
+		{"singleton", "site", false, "site", "", true, "id"},
+		{"missing key", "countries/", true, "", "", false, ""},
+		{"missing collection", "/DE", true, "", "", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: tt.id}, resp)
+
+			require.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tt.expectErr {
+				return
+			}
+
+			var result ItemResourceModel
+			resp.State.Get(context.Background(), &result)
+			assert.Equal(t, tt.id, result.ID.ValueString())
+			assert.Equal(t, tt.collection, result.Collection.ValueString())
+			assert.Equal(t, tt.primaryKey, result.PrimaryKey.ValueString())
+			assert.Equal(t, tt.wantSingleton, result.Singleton.ValueBool())
+			assert.Equal(t, tt.wantPrimaryKeyField, result.PrimaryKeyField.ValueString())
+		})
+	}
+}
@@ -0,0 +1,734 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &ItemsResource{}
+	_ resource.ResourceWithConfigure      = &ItemsResource{}
+	_ resource.ResourceWithModifyPlan     = &ItemsResource{}
+	_ resource.ResourceWithValidateConfig = &ItemsResource{}
+)
+
+// NewItemsResource creates a new items resource.
+func NewItemsResource() resource.Resource {
+	return &ItemsResource{}
+}
+
+// ItemsResource defines the resource implementation.
+type ItemsResource struct {
+	client *client.Client
+}
+
+// ItemsResourceModel describes the resource data model.
+type ItemsResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Collection      types.String `tfsdk:"collection"`
+	PrimaryKeyField types.String `tfsdk:"primary_key_field"`
+	Records         types.String `tfsdk:"records"`
+	Source          types.String `tfsdk:"source"`
+	RecordsHash     types.String `tfsdk:"records_hash"`
+	PrimaryKeys     types.List   `tfsdk:"primary_keys"`
+}
+
+func (r *ItemsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_items"
+}
+
+func (r *ItemsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Items resource. Seeds a custom collection with a list of records from an inline JSON " +
+			"array or a local JSON or CSV file. The resource is authoritative for the whole collection: records are upserted " +
+			"by primary key and items that are not in the list are deleted. Only the fields present in the records are compared.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The resource identifier (equal to `collection`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				MarkdownDescription: "The name of the collection to seed. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_key_field": schema.StringAttribute{
+				MarkdownDescription: "The name of the collection's primary key field. Every record must set it. Defaults to `id`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("id"),
+			},
+			"records": schema.StringAttribute{
+				MarkdownDescription: "JSON array of records, e.g. `jsonencode([{ id = 1, name = \"News\" }])`. Conflicts with `source`.",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to a local `.json` file holding an array of records, or a `.csv` file with a header row. " +
+					"Empty CSV cells are sent as null. Conflicts with `records`.",
+				Optional: true,
+			},
+			"records_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the normalized records, computed at plan time. Drift in Directus changes the hash.",
+				Computed:            true,
+			},
+			"primary_keys": schema.ListAttribute{
+				MarkdownDescription: "The sorted primary keys of the items in the collection.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures exactly one record origin is configured.
+func (r *ItemsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ItemsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Records.IsUnknown() || data.Source.IsUnknown() {
+		return
+	}
+
+	if data.Records.IsNull() == data.Source.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Items Configuration",
+			"Exactly one of records or source must be set.",
+		)
+		return
+	}
+
+	if !data.Records.IsNull() {
+		if _, err := parseItemsJSON([]byte(data.Records.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("records"), "Invalid Items Records", err.Error())
+		}
+	}
+
+	if !data.Source.IsNull() {
+		switch strings.ToLower(filepath.Ext(data.Source.ValueString())) {
+		case ".json", ".csv":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source"),
+				"Invalid Items Source",
+				fmt.Sprintf("source must be a .json or .csv file, got: %q", data.Source.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *ItemsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan loads the records so that changes to the source file, and drift detected by
+// Read, show up in the plan through records_hash and primary_keys.
+func (r *ItemsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ItemsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Records.IsUnknown() || plan.Source.IsUnknown() || plan.PrimaryKeyField.IsUnknown() {
+		plan.RecordsHash = types.StringUnknown()
+		plan.PrimaryKeys = types.ListUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	records, err := loadItemsRecords(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Loading Items Records", err.Error())
+		return
+	}
+
+	plan.RecordsHash = types.StringValue(hashItemsRecords(records, plan.PrimaryKeyField.ValueString()))
+	plan.PrimaryKeys = itemsPrimaryKeysValue(records, plan.PrimaryKeyField.ValueString())
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ItemsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ItemsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.sync(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Items",
+			"Could not seed collection "+plan.Collection.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read compares the items in the collection with the records and reports drift through
+// records_hash. When the records cannot be loaded, for example because the source file was
+// removed, the state is kept as is.
+func (r *ItemsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ItemsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := loadItemsRecords(state)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Items Records Unavailable",
+			"Could not load the records of collection "+state.Collection.ValueString()+" to detect drift: "+err.Error(),
+		)
+		return
+	}
+
+	pkField := state.PrimaryKeyField.ValueString()
+	existing, err := r.listItems(ctx, state.Collection.ValueString(), pkField, records)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Items",
+			"Could not read items of collection "+state.Collection.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.RecordsHash = types.StringValue(hashItemsRecords(projectItems(existing, records, pkField), pkField))
+	state.PrimaryKeys = itemsPrimaryKeysValue(existing, pkField)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ItemsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ItemsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.sync(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Items",
+			"Could not seed collection "+plan.Collection.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the seeded items from the collection.
+func (r *ItemsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ItemsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var primaryKeys []string
+	resp.Diagnostics.Append(state.PrimaryKeys.ElementsAs(ctx, &primaryKeys, false)...)
+	if resp.Diagnostics.HasError() || len(primaryKeys) == 0 {
+		return
+	}
+
+	keys := make([]interface{}, len(primaryKeys))
+	for i, key := range primaryKeys {
+		keys[i] = key
+	}
+
+	if err := r.client.DeleteMany(ctx, state.Collection.ValueString(), keys); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Items",
+			"Could not delete items of collection "+state.Collection.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// sync makes the collection match the records: new records are created in dependency
+// order, changed records are updated and items that are not in the records are deleted.
+func (r *ItemsResource) sync(ctx context.Context, data *ItemsResourceModel) error {
+	collection := data.Collection.ValueString()
+	pkField := data.PrimaryKeyField.ValueString()
+
+	records, err := loadItemsRecords(*data)
+	if err != nil {
+		return err
+	}
+
+	existing, err := r.listItems(ctx, collection, pkField, records)
+	if err != nil {
+		return err
+	}
+
+	toCreate, toUpdate, toDelete := diffItems(records, existing, pkField)
+
+	if len(toCreate) > 1 {
+		refFields, err := r.selfReferenceFields(ctx, collection)
+		if err != nil {
+			return err
+		}
+		if toCreate, err = orderItemsByReferences(toCreate, pkField, refFields); err != nil {
+			return err
+		}
+	}
+
+	if len(toCreate) > 0 {
+		if err := r.client.CreateMany(ctx, collection, toCreate, nil); err != nil {
+			return fmt.Errorf("creating %d items: %w", len(toCreate), err)
+		}
+	}
+	if len(toUpdate) > 0 {
+		if err := r.client.UpdateMany(ctx, collection, toUpdate, nil); err != nil {
+			return fmt.Errorf("updating %d items: %w", len(toUpdate), err)
+		}
+	}
+	if len(toDelete) > 0 {
+		if err := r.client.DeleteMany(ctx, collection, toDelete); err != nil {
+			return fmt.Errorf("deleting %d items: %w", len(toDelete), err)
+		}
+	}
+
+	data.ID = types.StringValue(collection)
+	data.RecordsHash = types.StringValue(hashItemsRecords(records, pkField))
+	data.PrimaryKeys = itemsPrimaryKeysValue(records, pkField)
+	return nil
+}
+
+// listItems returns every item of the collection, restricted to the primary key and
+// the fields used by the records.
+func (r *ItemsResource) listItems(ctx context.Context, collection, pkField string, records []map[string]interface{}) ([]map[string]interface{}, error) {
+	fields := map[string]bool{pkField: true}
+	for _, record := range records {
+		for key := range record {
+			fields[key] = true
+		}
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	params := map[string]string{
+		"fields": strings.Join(names, ","),
+		"sort":   pkField,
+		"limit":  "-1",
+	}
+
+	if err := r.client.ListWithParams(ctx, collection, params, &result); err != nil {
+		return nil, err
+	}
+
+	var items []map[string]interface{}
+	if len(result.Data) == 0 {
+		return items, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(result.Data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&items); err != nil {
+		return nil, fmt.Errorf("failed to decode items: %w", err)
+	}
+	return items, nil
+}
+
+// selfReferenceFields returns the M2O fields of the collection that point back to the
+// collection itself, e.g. a parent category.
+func (r *ItemsResource) selfReferenceFields(ctx context.Context, collection string) ([]string, error) {
+	var result struct {
+		Data []struct {
+			Collection        string `json:"collection"`
+			Field             string `json:"field"`
+			RelatedCollection string `json:"related_collection"`
+		} `json:"data"`
+	}
+
+	// GET /relations/{collection} lists the relations of a single collection.
+	if err := r.client.Get(ctx, "relations", collection, &result); err != nil {
+		return nil, fmt.Errorf("reading relations: %w", err)
+	}
+
+	var fields []string
+	for _, relation := range result.Data {
+		if relation.Collection == collection && relation.RelatedCollection == collection {
+			fields = append(fields, relation.Field)
+		}
+	}
+	return fields, nil
+}
+
+// loadItemsRecords returns the records configured inline or in the source file, and
+// checks that every record has a unique primary key.
+func loadItemsRecords(data ItemsResourceModel) ([]map[string]interface{}, error) {
+	var records []map[string]interface{}
+	var err error
+
+	switch {
+	case !data.Records.IsNull():
+		records, err = parseItemsJSON([]byte(data.Records.ValueString()))
+	case !data.Source.IsNull():
+		records, err = readItemsFile(data.Source.ValueString())
+	default:
+		return nil, fmt.Errorf("exactly one of records or source must be set")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pkField := data.PrimaryKeyField.ValueString()
+	seen := make(map[string]bool, len(records))
+	for i, record := range records {
+		value, ok := record[pkField]
+		if !ok || value == nil {
+			return nil, fmt.Errorf("record %d has no value for primary key field %q", i, pkField)
+		}
+		key := itemsKey(value)
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate primary key %q", key)
+		}
+		seen[key] = true
+	}
+
+	return records, nil
+}
+
+// readItemsFile reads records from a .json or .csv file.
+func readItemsFile(filePath string) ([]map[string]interface{}, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(filepath.Ext(filePath), ".csv") {
+		return parseItemsCSV(bytes.NewReader(content))
+	}
+	return parseItemsJSON(content)
+}
+
+// parseItemsJSON decodes a JSON array of objects. Numbers are kept as json.Number.
+func parseItemsJSON(content []byte) ([]map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var records []map[string]interface{}
+	if err := decoder.Decode(&records); err != nil {
+		return nil, fmt.Errorf("records must be a JSON array of objects: %w", err)
+	}
+	for i, record := range records {
+		if record == nil {
+			return nil, fmt.Errorf("record %d is not a JSON object", i)
+		}
+	}
+	return records, nil
+}
+
+// parseItemsCSV decodes a CSV document with a header row. Empty cells become null.
+func parseItemsCSV(reader io.Reader) ([]map[string]interface{}, error) {
+	rows, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("CSV file has no header row")
+	}
+
+	header := rows[0]
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	records := make([]map[string]interface{}, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		record := make(map[string]interface{}, len(header))
+		for i, field := range header {
+			if row[i] == "" {
+				record[field] = nil
+			} else {
+				record[field] = row[i]
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// itemsKey renders a field value in a type-insensitive form, so that the CSV string "1"
+// and the integer 1 returned by the API compare equal.
+func itemsKey(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		encoded, _ := json.Marshal(v)
+		normalized, err := normalizeJSON(encoded)
+		if err != nil {
+			return string(encoded)
+		}
+		return normalized
+	}
+}
+
+// itemsValuesEqual reports whether a record value equals the value of an existing item.
+// Numbers are compared by value when either side is a number, so that the CSV cell "1.50"
+// matches 1.5. Two strings are compared as they are, keeping e.g. "007" and "7" apart.
+func itemsValuesEqual(value, existing interface{}) bool {
+	if value == nil || existing == nil {
+		return value == nil && existing == nil
+	}
+	if itemsKey(value) == itemsKey(existing) {
+		return true
+	}
+
+	_, valueIsString := value.(string)
+	_, existingIsString := existing.(string)
+	if valueIsString && existingIsString {
+		return false
+	}
+	a, aOK := itemsNumber(value)
+	b, bOK := itemsNumber(existing)
+	return aOK && bOK && a == b
+}
+
+// itemsNumber returns the numeric value of a number or of a string holding a number.
+func itemsNumber(value interface{}) (float64, bool) {
+	var s string
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		s = v.String()
+	case string:
+		s = strings.TrimSpace(v)
+	default:
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
+
+// canonicalItem converts a record into a comparable form with type-insensitive values.
+func canonicalItem(record map[string]interface{}) map[string]interface{} {
+	canonical := make(map[string]interface{}, len(record))
+	for key, value := range record {
+		if value == nil {
+			canonical[key] = nil
+		} else {
+			canonical[key] = itemsKey(value)
+		}
+	}
+	return canonical
+}
+
+// hashItemsRecords returns the SHA-256 hash of the canonical records sorted by primary key.
+func hashItemsRecords(records []map[string]interface{}, pkField string) string {
+	canonical := make([]map[string]interface{}, len(records))
+	for i, record := range records {
+		canonical[i] = canonicalItem(record)
+	}
+	sort.SliceStable(canonical, func(i, j int) bool {
+		return itemsKey(canonical[i][pkField]) < itemsKey(canonical[j][pkField])
+	})
+
+	encoded, _ := json.Marshal(canonical)
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// projectItems restricts each existing item to the fields of the record with the same
+// primary key. Items without a matching record keep only their primary key.
+func projectItems(existing, records []map[string]interface{}, pkField string) []map[string]interface{} {
+	byKey := make(map[string]map[string]interface{}, len(records))
+	for _, record := range records {
+		byKey[itemsKey(record[pkField])] = record
+	}
+
+	projected := make([]map[string]interface{}, 0, len(existing))
+	for _, item := range existing {
+		record, ok := byKey[itemsKey(item[pkField])]
+		if !ok {
+			projected = append(projected, map[string]interface{}{pkField: item[pkField]})
+			continue
+		}
+		p := make(map[string]interface{}, len(record))
+		for key, value := range record {
+			// Numbers that only differ in formatting are taken from the record, so that
+			// they hash the same.
+			if itemsKey(value) != itemsKey(item[key]) && itemsValuesEqual(value, item[key]) {
+				p[key] = value
+			} else {
+				p[key] = item[key]
+			}
+		}
+		projected = append(projected, p)
+	}
+	return projected
+}
+
+// itemsPrimaryKeysValue returns the sorted primary keys of the items as a list value.
+func itemsPrimaryKeysValue(items []map[string]interface{}, pkField string) types.List {
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, itemsKey(item[pkField]))
+	}
+	sort.Strings(keys)
+
+	values := make([]types.String, len(keys))
+	for i, key := range keys {
+		values[i] = types.StringValue(key)
+	}
+	list, _ := types.ListValueFrom(context.Background(), types.StringType, values)
+	return list
+}
+
+// diffItems compares the records with the existing items by primary key.
+func diffItems(records, existing []map[string]interface{}, pkField string) (toCreate, toUpdate []map[string]interface{}, toDelete []interface{}) {
+	existingByKey := make(map[string]map[string]interface{}, len(existing))
+	for _, item := range existing {
+		existingByKey[itemsKey(item[pkField])] = item
+	}
+
+	wanted := make(map[string]bool, len(records))
+	for _, record := range records {
+		key := itemsKey(record[pkField])
+		wanted[key] = true
+
+		item, ok := existingByKey[key]
+		if !ok {
+			toCreate = append(toCreate, record)
+			continue
+		}
+		for field, value := range record {
+			if !itemsValuesEqual(value, item[field]) {
+				toUpdate = append(toUpdate, record)
+				break
+			}
+		}
+	}
+
+	for _, item := range existing {
+		if !wanted[itemsKey(item[pkField])] {
+			toDelete = append(toDelete, item[pkField])
+		}
+	}
+
+	return toCreate, toUpdate, toDelete
+}
+
+// orderItemsByReferences orders new records so that a record referencing another new
+// record through one of the given M2O fields comes after it. The original order is kept
+// otherwise. Reference cycles cannot be inserted in one pass and are reported as an error.
+func orderItemsByReferences(records []map[string]interface{}, pkField string, refFields []string) ([]map[string]interface{}, error) {
+	if len(refFields) == 0 {
+		return records, nil
+	}
+
+	pending := make(map[string]bool, len(records))
+	for _, record := range records {
+		pending[itemsKey(record[pkField])] = true
+	}
+
+	ordered := make([]map[string]interface{}, 0, len(records))
+	remaining := records
+	for len(remaining) > 0 {
+		var next []map[string]interface{}
+		for _, record := range remaining {
+			key := itemsKey(record[pkField])
+			ready := true
+			for _, field := range refFields {
+				target := record[field]
+				if target == nil {
+					continue
+				}
+				if targetKey := itemsKey(target); targetKey != key && pending[targetKey] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				ordered = append(ordered, record)
+				delete(pending, key)
+			} else {
+				next = append(next, record)
+			}
+		}
+
+		if len(next) == len(remaining) {
+			keys := make([]string, len(next))
+			for i, record := range next {
+				keys[i] = itemsKey(record[pkField])
+			}
+			return nil, fmt.Errorf("records %s reference each other through fields %s and cannot be created in one pass",
+				strings.Join(keys, ", "), strings.Join(refFields, ", "))
+		}
+		remaining = next
+	}
+
+	return ordered, nil
+}
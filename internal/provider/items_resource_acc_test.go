@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccItemsCollectionConfig = `
+resource "directus_collection" "test" {
+  collection = "acc_test_seed"
+}
+`
+
+func TestAccItems_inline(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_collection", "collections"),
+		Steps: []resource.TestStep{
+			// The provider does not manage fields yet, so the name field is added over the API.
+			{
+				Config: testAccProviderConfig() + testAccItemsCollectionConfig,
+			},
+			{
+				PreConfig: func() { testAccCreateStringField(t, "acc_test_seed", "name") },
+				Config: testAccProviderConfig() + testAccItemsCollectionConfig + `
+resource "directus_items" "test" {
+  collection = directus_collection.test.collection
+
+  records = jsonencode([
+    { id = 1, name = "News" },
+    { id = 2, name = "Sports" },
+    { id = 3, name = "Weather" },
+  ])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_items.test", "id", "acc_test_seed"),
+					resource.TestCheckResourceAttr("directus_items.test", "primary_keys.#", "3"),
+					resource.TestCheckResourceAttrSet("directus_items.test", "records_hash"),
+				),
+			},
+			// Change one record and drop another
+			{
+				Config: testAccProviderConfig() + testAccItemsCollectionConfig + `
+resource "directus_items" "test" {
+  collection = directus_collection.test.collection
+
+  records = jsonencode([
+    { id = 1, name = "Breaking News" },
+    { id = 2, name = "Sports" },
+  ])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_items.test", "primary_keys.#", "2"),
+					resource.TestCheckResourceAttr("directus_items.test", "primary_keys.0", "1"),
+					resource.TestCheckResourceAttr("directus_items.test", "primary_keys.1", "2"),
+				),
+			},
+		},
+	})
+}
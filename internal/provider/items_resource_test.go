@@ -0,0 +1,493 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestItemsResourceSchema(t *testing.T) {
+	r := &ItemsResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "collection", "primary_key_field", "records", "source", "records_hash", "primary_keys"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["collection"].IsRequired())
+	assert.True(t, schemaResp.Schema.Attributes["records_hash"].IsComputed())
+}
+
+func TestItemsResourceMetadata(t *testing.T) {
+	r := &ItemsResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_items", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newItemsModel() *ItemsResourceModel {
+	return &ItemsResourceModel{
+		ID:              types.StringNull(),
+		Collection:      types.StringValue("categories"),
+		PrimaryKeyField: types.StringValue("id"),
+		Records:         types.StringValue(`[{"id":1,"name":"News"},{"id":2,"name":"Sports"}]`),
+		Source:          types.StringNull(),
+		RecordsHash:     types.StringNull(),
+		PrimaryKeys:     types.ListNull(types.StringType),
+	}
+}
+
+func TestItemsResource_ValidateConfig(t *testing.T) {
+	r := &ItemsResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *ItemsResourceModel)
+		expectErr bool
+	}{
+		{"records", func(m *ItemsResourceModel) {}, false},
+		{"json source", func(m *ItemsResourceModel) {
+			m.Records = types.StringNull()
+			m.Source = types.StringValue("seed/categories.json")
+		}, false},
+		{"csv source", func(m *ItemsResourceModel) {
+			m.Records = types.StringNull()
+			m.Source = types.StringValue("seed/categories.CSV")
+		}, false},
+		{"unsupported source", func(m *ItemsResourceModel) {
+			m.Records = types.StringNull()
+			m.Source = types.StringValue("seed/categories.yaml")
+		}, true},
+		{"both origins", func(m *ItemsResourceModel) { m.Source = types.StringValue("seed/categories.json") }, true},
+		{"no origin", func(m *ItemsResourceModel) { m.Records = types.StringNull() }, true},
+		{"records not an array", func(m *ItemsResourceModel) { m.Records = types.StringValue(`{"id":1}`) }, true},
+		{"record not an object", func(m *ItemsResourceModel) { m.Records = types.StringValue(`[null]`) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newItemsModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Record loading
+// ---------------------------------------------------------------------------
+
+func TestLoadItemsRecords(t *testing.T) {
+	t.Run("inline", func(t *testing.T) {
+		records, err := loadItemsRecords(*newItemsModel())
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, json.Number("1"), records[0]["id"])
+	})
+
+	t.Run("csv file", func(t *testing.T) {
+		source := filepath.Join(t.TempDir(), "categories.csv")
+		require.NoError(t, os.WriteFile(source, []byte("id, name ,parent\n1,News,\n2,Local News,1\n"), 0o600))
+
+		model := newItemsModel()
+		model.Records = types.StringNull()
+		model.Source = types.StringValue(source)
+
+		records, err := loadItemsRecords(*model)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]interface{}{
+			{"id": "1", "name": "News", "parent": nil},
+			{"id": "2", "name": "Local News", "parent": "1"},
+		}, records)
+	})
+
+	t.Run("json file", func(t *testing.T) {
+		source := filepath.Join(t.TempDir(), "categories.json")
+		require.NoError(t, os.WriteFile(source, []byte(`[{"id":"news","name":"News"}]`), 0o600))
+
+		model := newItemsModel()
+		model.Records = types.StringNull()
+		model.Source = types.StringValue(source)
+
+		records, err := loadItemsRecords(*model)
+		require.NoError(t, err)
+		assert.Equal(t, "news", records[0]["id"])
+	})
+
+	t.Run("missing primary key", func(t *testing.T) {
+		model := newItemsModel()
+		model.Records = types.StringValue(`[{"id":1},{"name":"Sports"}]`)
+
+		_, err := loadItemsRecords(*model)
+		assert.ErrorContains(t, err, "record 1 has no value for primary key field")
+	})
+
+	t.Run("duplicate primary key", func(t *testing.T) {
+		model := newItemsModel()
+		model.Records = types.StringValue(`[{"id":1},{"id":"1"}]`)
+
+		_, err := loadItemsRecords(*model)
+		assert.ErrorContains(t, err, `duplicate primary key "1"`)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		model := newItemsModel()
+		model.Records = types.StringNull()
+		model.Source = types.StringValue(filepath.Join(t.TempDir(), "missing.json"))
+
+		_, err := loadItemsRecords(*model)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseItemsCSV_RaggedRows(t *testing.T) {
+	_, err := parseItemsCSV(strings.NewReader("id,name\n1\n"))
+	assert.Error(t, err)
+}
+
+// ---------------------------------------------------------------------------
+// Diffing, hashing and ordering
+// ---------------------------------------------------------------------------
+
+func TestHashItemsRecords_TypeAndOrderInsensitive(t *testing.T) {
+	a := []map[string]interface{}{
+		{"id": json.Number("2"), "name": "Sports", "active": true},
+		{"id": json.Number("1"), "name": "News", "active": false},
+	}
+	b := []map[string]interface{}{
+		{"id": "1", "name": "News", "active": "false"},
+		{"id": "2", "name": "Sports", "active": "true"},
+	}
+	assert.Equal(t, hashItemsRecords(a, "id"), hashItemsRecords(b, "id"))
+
+	b[1]["name"] = "Football"
+	assert.NotEqual(t, hashItemsRecords(a, "id"), hashItemsRecords(b, "id"))
+}
+
+func TestProjectItems(t *testing.T) {
+	records := []map[string]interface{}{{"id": "1", "name": "News"}}
+	existing := []map[string]interface{}{
+		{"id": json.Number("1"), "name": "News", "sort": json.Number("4")},
+		{"id": json.Number("9"), "name": "Manual"},
+	}
+
+	projected := projectItems(existing, records, "id")
+
+	assert.Equal(t, []map[string]interface{}{
+		{"id": json.Number("1"), "name": "News"},
+		{"id": json.Number("9")},
+	}, projected)
+}
+
+func TestDiffItems(t *testing.T) {
+	records := []map[string]interface{}{
+		{"id": json.Number("1"), "name": "News"},
+		{"id": json.Number("2"), "name": "Sports", "parent": nil},
+		{"id": json.Number("3"), "name": "Weather"},
+	}
+	existing := []map[string]interface{}{
+		{"id": json.Number("1"), "name": "News", "parent": json.Number("2")},
+		{"id": json.Number("2"), "name": "Sport", "parent": nil},
+		{"id": json.Number("4"), "name": "Obsolete"},
+	}
+
+	toCreate, toUpdate, toDelete := diffItems(records, existing, "id")
+
+	assert.Equal(t, []map[string]interface{}{records[2]}, toCreate)
+	assert.Equal(t, []map[string]interface{}{records[1]}, toUpdate)
+	assert.Equal(t, []interface{}{json.Number("4")}, toDelete)
+}
+
+func TestDiffItems_NumbersComparedByValue(t *testing.T) {
+	records := []map[string]interface{}{
+		{"id": "1", "price": "1.50"},
+		{"id": "2", "price": json.Number("2.0")},
+		{"id": "3", "code": "007"},
+	}
+	existing := []map[string]interface{}{
+		{"id": json.Number("1"), "price": json.Number("1.5")},
+		{"id": json.Number("2"), "price": json.Number("2")},
+		{"id": json.Number("3"), "code": "7"},
+	}
+
+	toCreate, toUpdate, toDelete := diffItems(records, existing, "id")
+
+	assert.Empty(t, toCreate)
+	assert.Equal(t, []map[string]interface{}{records[2]}, toUpdate)
+	assert.Empty(t, toDelete)
+
+	existing[2]["code"] = "007"
+	projected := projectItems(existing, records, "id")
+	assert.Equal(t, hashItemsRecords(records, "id"), hashItemsRecords(projected, "id"))
+}
+
+func TestOrderItemsByReferences(t *testing.T) {
+	t.Run("parents first", func(t *testing.T) {
+		records := []map[string]interface{}{
+			{"id": "3", "parent": "2"},
+			{"id": "2", "parent": "1"},
+			{"id": "1", "parent": nil},
+			{"id": "4", "parent": "99"},
+		}
+
+		ordered, err := orderItemsByReferences(records, "id", []string{"parent"})
+		require.NoError(t, err)
+
+		keys := make([]string, len(ordered))
+		for i, record := range ordered {
+			keys[i] = record["id"].(string)
+		}
+		assert.Equal(t, []string{"1", "4", "2", "3"}, keys)
+	})
+
+	t.Run("self reference", func(t *testing.T) {
+		records := []map[string]interface{}{{"id": "1", "parent": "1"}}
+
+		ordered, err := orderItemsByReferences(records, "id", []string{"parent"})
+		require.NoError(t, err)
+		assert.Len(t, ordered, 1)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		records := []map[string]interface{}{
+			{"id": "1", "parent": "2"},
+			{"id": "2", "parent": "1"},
+		}
+
+		_, err := orderItemsByReferences(records, "id", []string{"parent"})
+		assert.ErrorContains(t, err, "records 1, 2 reference each other")
+	})
+}
+
+// ---------------------------------------------------------------------------
+// ModifyPlan
+// ---------------------------------------------------------------------------
+
+func TestItemsResource_ModifyPlan(t *testing.T) {
+	r := &ItemsResource{}
+	schema := getResourceSchema(t, r)
+
+	model := newItemsModel()
+	plan := makePlan(t, schema, model)
+
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: makeNullState(t, schema)}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "ModifyPlan diagnostics: %v", resp.Diagnostics)
+
+	var result ItemsResourceModel
+	resp.Plan.Get(context.Background(), &result)
+
+	records, _ := parseItemsJSON([]byte(model.Records.ValueString()))
+	assert.Equal(t, hashItemsRecords(records, "id"), result.RecordsHash.ValueString())
+
+	var keys []string
+	result.PrimaryKeys.ElementsAs(context.Background(), &keys, false)
+	assert.Equal(t, []string{"1", "2"}, keys)
+}
+
+func TestItemsResource_ModifyPlan_MissingSource(t *testing.T) {
+	r := &ItemsResource{}
+	schema := getResourceSchema(t, r)
+
+	model := newItemsModel()
+	model.Records = types.StringNull()
+	model.Source = types.StringValue(filepath.Join(t.TempDir(), "missing.csv"))
+	plan := makePlan(t, schema, model)
+
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: makeNullState(t, schema)}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestItemsResource_Create(t *testing.T) {
+	var requests []string
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/items/categories":
+			assert.Equal(t, "id,name,parent", req.URL.Query().Get("fields"))
+			assert.Equal(t, "-1", req.URL.Query().Get("limit"))
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": 1, "name": "Old News", "parent": nil},
+					{"id": 7, "name": "Manual", "parent": nil},
+				},
+			}), nil
+		case req.Method == "GET" && req.URL.Path == "/relations/categories":
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"collection": "categories", "field": "parent", "related_collection": "categories"},
+					{"collection": "categories", "field": "owner", "related_collection": "directus_users"},
+				},
+			}), nil
+		case req.Method == "POST":
+			var body []map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			require.Len(t, body, 2)
+			assert.Equal(t, float64(2), body[0]["id"], "parent must be created before its child")
+			assert.Equal(t, float64(3), body[1]["id"])
+			return mockJSONResponse(200, map[string]interface{}{"data": body}), nil
+		case req.Method == "PATCH":
+			var body []map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, []map[string]interface{}{{"id": float64(1), "name": "News", "parent": nil}}, body)
+			return mockJSONResponse(200, map[string]interface{}{"data": body}), nil
+		case req.Method == "DELETE":
+			var body []interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, []interface{}{float64(7)}, body)
+			return mockJSONResponse(204, nil), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &ItemsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemsModel()
+	model.ID = types.StringUnknown()
+	model.Records = types.StringValue(`[
+		{"id": 1, "name": "News", "parent": null},
+		{"id": 3, "name": "Local News", "parent": 2},
+		{"id": 2, "name": "Regional News", "parent": 1}
+	]`)
+	model.RecordsHash = types.StringUnknown()
+	model.PrimaryKeys = types.ListUnknown(types.StringType)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []string{
+		"GET /items/categories", "GET /relations/categories", "POST /items/categories",
+		"PATCH /items/categories", "DELETE /items/categories",
+	}, requests)
+
+	var result ItemsResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "categories", result.ID.ValueString())
+	assert.False(t, result.RecordsHash.IsUnknown())
+
+	var keys []string
+	result.PrimaryKeys.ElementsAs(context.Background(), &keys, false)
+	assert.Equal(t, []string{"1", "2", "3"}, keys)
+}
+
+func TestItemsResource_Read_DetectsDrift(t *testing.T) {
+	model := newItemsModel()
+	model.ID = types.StringValue("categories")
+	records, _ := parseItemsJSON([]byte(model.Records.ValueString()))
+	model.RecordsHash = types.StringValue(hashItemsRecords(records, "id"))
+	model.PrimaryKeys = itemsPrimaryKeysValue(records, "id")
+
+	tests := []struct {
+		name      string
+		existing  []map[string]interface{}
+		wantDrift bool
+		wantKeys  []string
+	}{
+		{"in sync", []map[string]interface{}{
+			{"id": 1, "name": "News", "sort": 1},
+			{"id": 2, "name": "Sports", "sort": 2},
+		}, false, []string{"1", "2"}},
+		{"changed value", []map[string]interface{}{
+			{"id": 1, "name": "News"},
+			{"id": 2, "name": "Football"},
+		}, true, []string{"1", "2"}},
+		{"extra item", []map[string]interface{}{
+			{"id": 1, "name": "News"},
+			{"id": 2, "name": "Sports"},
+			{"id": 10, "name": "Manual"},
+		}, true, []string{"1", "10", "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/items/categories", req.URL.Path)
+				return mockJSONResponse(200, map[string]interface{}{"data": tt.existing}), nil
+			})
+
+			r := &ItemsResource{client: mockClient}
+			schema := getResourceSchema(t, r)
+			state := makeState(t, schema, model)
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+			var result ItemsResourceModel
+			resp.State.Get(context.Background(), &result)
+			assert.Equal(t, tt.wantDrift, !result.RecordsHash.Equal(model.RecordsHash))
+
+			var keys []string
+			result.PrimaryKeys.ElementsAs(context.Background(), &keys, false)
+			assert.Equal(t, tt.wantKeys, keys)
+		})
+	}
+}
+
+func TestItemsResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/items/categories", req.URL.String())
+
+		var body []interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, []interface{}{"1", "2"}, body)
+
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &ItemsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newItemsModel()
+	model.ID = types.StringValue("categories")
+	model.RecordsHash = types.StringValue("hash")
+	records, _ := parseItemsJSON([]byte(model.Records.ValueString()))
+	model.PrimaryKeys = itemsPrimaryKeysValue(records, "id")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &OperationResource{}
+	_ resource.ResourceWithConfigure      = &OperationResource{}
+	_ resource.ResourceWithImportState    = &OperationResource{}
+	_ resource.ResourceWithValidateConfig = &OperationResource{}
+)
+
+// NewOperationResource creates a new operation resource.
+func NewOperationResource() resource.Resource {
+	return &OperationResource{}
+}
+
+// OperationResource defines the resource implementation.
+type OperationResource struct {
+	client *client.Client
+}
+
+// OperationResourceModel describes the resource data model.
+type OperationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Flow       types.String `tfsdk:"flow"`
+	Name       types.String `tfsdk:"name"`
+	Key        types.String `tfsdk:"key"`
+	Type       types.String `tfsdk:"type"`
+	PositionX  types.Int64  `tfsdk:"position_x"`
+	PositionY  types.Int64  `tfsdk:"position_y"`
+	Options    types.String `tfsdk:"options"`
+	Resolve    types.String `tfsdk:"resolve"`
+	Reject     types.String `tfsdk:"reject"`
+	Entrypoint types.Bool   `tfsdk:"entrypoint"`
+}
+
+func (r *OperationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation"
+}
+
+func (r *OperationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Operation resource. Operations are the steps of a flow. " +
+			"Chain operations by referencing the `id` of the next operation in `resolve` (on success) or `reject` (on failure); " +
+			"define the target operation first so that Terraform creates it before the operation pointing to it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the operation (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flow": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the flow this operation belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the operation.",
+				Optional:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Key of the operation, unique within the flow. Used to access the operation's result in later operations.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The operation type, e.g. `log`, `request`, `item-read`, `exec`, `condition` or `trigger`.",
+				Required:            true,
+			},
+			"position_x": schema.Int64Attribute{
+				MarkdownDescription: "Horizontal position of the operation on the flow grid.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"position_y": schema.Int64Attribute{
+				MarkdownDescription: "Vertical position of the operation on the flow grid.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"options": schema.StringAttribute{
+				MarkdownDescription: "JSON object of options for the operation type.",
+				Optional:            true,
+			},
+			"resolve": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the operation executed when this operation succeeds.",
+				Optional:            true,
+			},
+			"reject": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the operation executed when this operation fails.",
+				Optional:            true,
+			},
+			"entrypoint": schema.BoolAttribute{
+				MarkdownDescription: "Whether this operation is the first operation of the flow (`directus_flow.operation`). " +
+					"At most one operation per flow should set this.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the options JSON and rejects operations that chain to themselves.
+func (r *OperationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OperationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateJSONString(data.Options); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("options"), "Invalid Operation Options", err.Error())
+	}
+
+	if !data.Resolve.IsNull() && !data.Resolve.IsUnknown() && !data.Reject.IsNull() && !data.Reject.IsUnknown() &&
+		data.Resolve.ValueString() == data.Reject.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reject"),
+			"Invalid Operation Chain",
+			"resolve and reject cannot point to the same operation.",
+		)
+	}
+}
+
+func (r *OperationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *OperationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OperationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data operationAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "operations", buildOperationInput(plan, true), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Operation",
+			"Could not create operation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := response.Data.toModel(plan)
+	state.Entrypoint = types.BoolValue(false)
+
+	if plan.Entrypoint.ValueBool() {
+		if err := r.setFlowEntrypoint(ctx, state.Flow.ValueString(), state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Flow Entrypoint",
+				fmt.Sprintf("Could not set operation %s as entrypoint of flow %s: %s", state.ID.ValueString(), state.Flow.ValueString(), err.Error()),
+			)
+			// Save the created operation so it is tracked despite the error.
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+		state.Entrypoint = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *OperationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OperationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data operationAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "operations", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Operation",
+			"Could not read operation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	newState := response.Data.toModel(state)
+
+	entry, err := r.readFlowEntrypoint(ctx, newState.Flow.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Flow",
+			fmt.Sprintf("Could not read entrypoint of flow %s: %s", newState.Flow.ValueString(), err.Error()),
+		)
+		return
+	}
+	newState.Entrypoint = types.BoolValue(entry == newState.ID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *OperationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, prior OperationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data operationAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "operations", plan.ID.ValueString(), buildOperationInput(plan, false), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Operation",
+			"Could not update operation ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state := response.Data.toModel(plan)
+	state.Entrypoint = prior.Entrypoint
+
+	if !plan.Entrypoint.Equal(prior.Entrypoint) {
+		flowID, opID := state.Flow.ValueString(), state.ID.ValueString()
+		var err error
+
+		if plan.Entrypoint.ValueBool() {
+			err = r.setFlowEntrypoint(ctx, flowID, opID)
+		} else {
+			// Only clear the entrypoint if it still points to this operation.
+			var entry string
+			entry, err = r.readFlowEntrypoint(ctx, flowID)
+			if err == nil && entry == opID {
+				err = r.setFlowEntrypoint(ctx, flowID, "")
+			}
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Flow Entrypoint",
+				fmt.Sprintf("Could not update entrypoint of flow %s: %s", flowID, err.Error()),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+		state.Entrypoint = plan.Entrypoint
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *OperationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OperationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "operations", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Operation",
+			"Could not delete operation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *OperationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readFlowEntrypoint returns the UUID of the first operation of the flow, or "" if none is set.
+func (r *OperationResource) readFlowEntrypoint(ctx context.Context, flowID string) (string, error) {
+	var result struct {
+		Data struct {
+			Operation string `json:"operation,omitempty"`
+		} `json:"data"`
+	}
+
+	params := map[string]string{
+		"fields": "operation",
+	}
+
+	if err := r.client.GetWithParams(ctx, "flows", flowID, params, &result); err != nil {
+		return "", err
+	}
+
+	return result.Data.Operation, nil
+}
+
+// setFlowEntrypoint sets the first operation of the flow. An empty operationID clears it.
+func (r *OperationResource) setFlowEntrypoint(ctx context.Context, flowID, operationID string) error {
+	var value interface{}
+	if operationID != "" {
+		value = operationID
+	}
+
+	return r.client.Update(ctx, "flows", flowID, map[string]interface{}{"operation": value}, nil)
+}
+
+// operationAPIResponse represents the API response for operation operations.
+type operationAPIResponse struct {
+	ID        string          `json:"id"`
+	Flow      string          `json:"flow"`
+	Name      string          `json:"name,omitempty"`
+	Key       string          `json:"key"`
+	Type      string          `json:"type"`
+	PositionX int64           `json:"position_x"`
+	PositionY int64           `json:"position_y"`
+	Options   json.RawMessage `json:"options,omitempty"`
+	Resolve   string          `json:"resolve,omitempty"`
+	Reject    string          `json:"reject,omitempty"`
+}
+
+// toModel converts operationAPIResponse to OperationResourceModel.
+// Entrypoint is not part of the operation itself and is carried over from prior.
+func (o *operationAPIResponse) toModel(prior OperationResourceModel) *OperationResourceModel {
+	return &OperationResourceModel{
+		ID:         types.StringValue(o.ID),
+		Flow:       types.StringValue(o.Flow),
+		Name:       stringOrNull(o.Name),
+		Key:        types.StringValue(o.Key),
+		Type:       types.StringValue(o.Type),
+		PositionX:  types.Int64Value(o.PositionX),
+		PositionY:  types.Int64Value(o.PositionY),
+		Options:    jsonObjectValue(prior.Options, o.Options),
+		Resolve:    stringOrNull(o.Resolve),
+		Reject:     stringOrNull(o.Reject),
+		Entrypoint: prior.Entrypoint,
+	}
+}
+
+// buildOperationInput constructs the input from the resource model (used for both create and update)
+func buildOperationInput(data OperationResourceModel, isCreate bool) map[string]interface{} {
+	input := map[string]interface{}{
+		"key":  data.Key.ValueString(),
+		"type": data.Type.ValueString(),
+	}
+
+	setInt64Field(input, "position_x", data.PositionX)
+	setInt64Field(input, "position_y", data.PositionY)
+	setJSONField(input, "options", data.Options)
+
+	if isCreate {
+		input["flow"] = data.Flow.ValueString()
+		setStringField(input, "name", data.Name)
+		setStringField(input, "resolve", data.Resolve)
+		setStringField(input, "reject", data.Reject)
+	} else {
+		setNullableStringField(input, "name", data.Name)
+		setNullableStringField(input, "resolve", data.Resolve)
+		setNullableStringField(input, "reject", data.Reject)
+		if data.Options.IsNull() {
+			input["options"] = nil
+		}
+	}
+
+	return input
+}
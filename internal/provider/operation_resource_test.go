@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestOperationResourceSchema(t *testing.T) {
+	r := &OperationResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "flow", "name", "key", "type", "position_x", "position_y", "options", "resolve", "reject", "entrypoint"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["flow"].IsRequired())
+	assert.True(t, schemaResp.Schema.Attributes["key"].IsRequired())
+	assert.True(t, schemaResp.Schema.Attributes["type"].IsRequired())
+}
+
+func TestOperationResourceMetadata(t *testing.T) {
+	r := &OperationResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_operation", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newOperationModel() *OperationResourceModel {
+	return &OperationResourceModel{
+		ID:         types.StringNull(),
+		Flow:       types.StringValue("flow-1"),
+		Name:       types.StringNull(),
+		Key:        types.StringValue("log_it"),
+		Type:       types.StringValue("log"),
+		PositionX:  types.Int64Value(0),
+		PositionY:  types.Int64Value(0),
+		Options:    types.StringNull(),
+		Resolve:    types.StringNull(),
+		Reject:     types.StringNull(),
+		Entrypoint: types.BoolValue(false),
+	}
+}
+
+func TestOperationResource_ValidateConfig(t *testing.T) {
+	r := &OperationResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *OperationResourceModel)
+		expectErr bool
+	}{
+		{"valid", func(m *OperationResourceModel) {}, false},
+		{"valid options", func(m *OperationResourceModel) { m.Options = types.StringValue(`{"message":"hi"}`) }, false},
+		{"invalid options", func(m *OperationResourceModel) { m.Options = types.StringValue(`{"message":`) }, true},
+		{"distinct resolve and reject", func(m *OperationResourceModel) {
+			m.Resolve = types.StringValue("op-2")
+			m.Reject = types.StringValue("op-3")
+		}, false},
+		{"same resolve and reject", func(m *OperationResourceModel) {
+			m.Resolve = types.StringValue("op-2")
+			m.Reject = types.StringValue("op-2")
+		}, true},
+		{"unknown resolve", func(m *OperationResourceModel) {
+			m.Resolve = types.StringUnknown()
+			m.Reject = types.StringValue("op-2")
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newOperationModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildOperationInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildOperationInput(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		model := newOperationModel()
+		model.Resolve = types.StringValue("op-2")
+		model.Options = types.StringValue(`{"message":"hi"}`)
+
+		input := buildOperationInput(*model, true)
+
+		assert.Equal(t, "flow-1", input["flow"])
+		assert.Equal(t, "log_it", input["key"])
+		assert.Equal(t, "log", input["type"])
+		assert.Equal(t, int64(0), input["position_x"])
+		assert.Equal(t, "op-2", input["resolve"])
+		assert.Equal(t, map[string]interface{}{"message": "hi"}, input["options"])
+		assert.NotContains(t, input, "reject")
+		assert.NotContains(t, input, "entrypoint")
+	})
+
+	t.Run("update clears removed links", func(t *testing.T) {
+		input := buildOperationInput(*newOperationModel(), false)
+
+		assert.NotContains(t, input, "flow")
+		assert.Contains(t, input, "resolve")
+		assert.Nil(t, input["resolve"])
+		assert.Contains(t, input, "reject")
+		assert.Nil(t, input["reject"])
+		assert.Contains(t, input, "options")
+		assert.Nil(t, input["options"])
+	})
+}
+
+func TestOperationAPIResponseToModel(t *testing.T) {
+	prior := newOperationModel()
+	prior.Entrypoint = types.BoolValue(true)
+
+	model := (&operationAPIResponse{
+		ID: "op-1", Flow: "flow-1", Key: "log_it", Type: "log",
+		PositionX: 19, PositionY: 1, Options: json.RawMessage(`{}`), Resolve: "op-2",
+	}).toModel(*prior)
+
+	assert.Equal(t, "op-1", model.ID.ValueString())
+	assert.Equal(t, int64(19), model.PositionX.ValueInt64())
+	assert.True(t, model.Name.IsNull())
+	assert.True(t, model.Options.IsNull())
+	assert.Equal(t, "op-2", model.Resolve.ValueString())
+	assert.True(t, model.Reject.IsNull())
+	assert.True(t, model.Entrypoint.ValueBool(), "entrypoint is carried over from prior")
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func operationResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"id": "op-1", "flow": "flow-1", "name": nil, "key": "log_it", "type": "log",
+			"position_x": 0, "position_y": 0, "options": map[string]interface{}{}, "resolve": nil, "reject": nil,
+		},
+	}
+}
+
+func TestOperationResource_Create(t *testing.T) {
+	t.Run("without entrypoint", func(t *testing.T) {
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "POST", req.Method)
+			assert.Equal(t, "http://example.com/operations", req.URL.String())
+			return mockJSONResponse(200, operationResponse()), nil
+		})
+
+		r := &OperationResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		model := newOperationModel()
+		model.ID = types.StringUnknown()
+
+		resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+		r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+		var result OperationResourceModel
+		resp.State.Get(context.Background(), &result)
+		assert.Equal(t, "op-1", result.ID.ValueString())
+		assert.False(t, result.Entrypoint.ValueBool())
+	})
+
+	t.Run("as entrypoint", func(t *testing.T) {
+		var calls []string
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, req.Method+" "+req.URL.Path)
+
+			if req.URL.Path == "/flows/flow-1" {
+				var body map[string]interface{}
+				bodyBytes, _ := io.ReadAll(req.Body)
+				json.Unmarshal(bodyBytes, &body)
+				assert.Equal(t, "op-1", body["operation"])
+				return mockJSONResponse(200, map[string]interface{}{"data": map[string]interface{}{"id": "flow-1"}}), nil
+			}
+			return mockJSONResponse(200, operationResponse()), nil
+		})
+
+		r := &OperationResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		model := newOperationModel()
+		model.ID = types.StringUnknown()
+		model.Entrypoint = types.BoolValue(true)
+
+		resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+		r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+		assert.Equal(t, []string{"POST /operations", "PATCH /flows/flow-1"}, calls)
+
+		var result OperationResourceModel
+		resp.State.Get(context.Background(), &result)
+		assert.True(t, result.Entrypoint.ValueBool())
+	})
+}
+
+func TestOperationResource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+
+		switch req.URL.Path {
+		case "/operations/op-1":
+			return mockJSONResponse(200, operationResponse()), nil
+		case "/flows/flow-1":
+			assert.Equal(t, "operation", req.URL.Query().Get("fields"))
+			return mockJSONResponse(200, map[string]interface{}{"data": map[string]interface{}{"operation": "op-1"}}), nil
+		}
+		t.Fatalf("unexpected request: %s", req.URL.Path)
+		return nil, nil
+	})
+
+	r := &OperationResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newOperationModel()
+	model.ID = types.StringValue("op-1")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result OperationResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.True(t, result.Entrypoint.ValueBool(), "entrypoint should be detected from the flow")
+}
+
+func TestOperationResource_Update(t *testing.T) {
+	t.Run("unset entrypoint clears flow operation", func(t *testing.T) {
+		var calls []string
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, req.Method+" "+req.URL.Path)
+
+			switch {
+			case req.Method == "GET" && req.URL.Path == "/flows/flow-1":
+				return mockJSONResponse(200, map[string]interface{}{"data": map[string]interface{}{"operation": "op-1"}}), nil
+			case req.Method == "PATCH" && req.URL.Path == "/flows/flow-1":
+				var body map[string]interface{}
+				bodyBytes, _ := io.ReadAll(req.Body)
+				json.Unmarshal(bodyBytes, &body)
+				assert.Contains(t, body, "operation")
+				assert.Nil(t, body["operation"])
+				return mockJSONResponse(200, map[string]interface{}{"data": map[string]interface{}{"id": "flow-1"}}), nil
+			}
+			return mockJSONResponse(200, operationResponse()), nil
+		})
+
+		r := &OperationResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		priorModel := newOperationModel()
+		priorModel.ID = types.StringValue("op-1")
+		priorModel.Entrypoint = types.BoolValue(true)
+		planModel := newOperationModel()
+		planModel.ID = types.StringValue("op-1")
+
+		resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+		r.Update(context.Background(), fwresource.UpdateRequest{
+			Plan:  makePlan(t, schema, planModel),
+			State: makeState(t, schema, priorModel),
+		}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+		assert.Equal(t, []string{"PATCH /operations/op-1", "GET /flows/flow-1", "PATCH /flows/flow-1"}, calls)
+
+		var result OperationResourceModel
+		resp.State.Get(context.Background(), &result)
+		assert.False(t, result.Entrypoint.ValueBool())
+	})
+
+	t.Run("unset entrypoint leaves another operation alone", func(t *testing.T) {
+		var calls []string
+		mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, req.Method+" "+req.URL.Path)
+
+			if req.URL.Path == "/flows/flow-1" {
+				return mockJSONResponse(200, map[string]interface{}{"data": map[string]interface{}{"operation": "op-9"}}), nil
+			}
+			return mockJSONResponse(200, operationResponse()), nil
+		})
+
+		r := &OperationResource{client: mockClient}
+		schema := getResourceSchema(t, r)
+
+		priorModel := newOperationModel()
+		priorModel.ID = types.StringValue("op-1")
+		priorModel.Entrypoint = types.BoolValue(true)
+		planModel := newOperationModel()
+		planModel.ID = types.StringValue("op-1")
+
+		resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+		r.Update(context.Background(), fwresource.UpdateRequest{
+			Plan:  makePlan(t, schema, planModel),
+			State: makeState(t, schema, priorModel),
+		}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+		assert.Equal(t, []string{"PATCH /operations/op-1", "GET /flows/flow-1"}, calls)
+	})
+}
+
+func TestOperationResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/operations/op-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &OperationResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newOperationModel()
+	model.ID = types.StringValue("op-1")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &PanelResource{}
+	_ resource.ResourceWithConfigure      = &PanelResource{}
+	_ resource.ResourceWithImportState    = &PanelResource{}
+	_ resource.ResourceWithValidateConfig = &PanelResource{}
+)
+
+// NewPanelResource creates a new panel resource.
+func NewPanelResource() resource.Resource {
+	return &PanelResource{}
+}
+
+// PanelResource defines the resource implementation.
+type PanelResource struct {
+	client *client.Client
+}
+
+// PanelResourceModel describes the resource data model.
+type PanelResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Dashboard  types.String `tfsdk:"dashboard"`
+	Type       types.String `tfsdk:"type"`
+	PositionX  types.Int64  `tfsdk:"position_x"`
+	PositionY  types.Int64  `tfsdk:"position_y"`
+	Width      types.Int64  `tfsdk:"width"`
+	Height     types.Int64  `tfsdk:"height"`
+	ShowHeader types.Bool   `tfsdk:"show_header"`
+	Name       types.String `tfsdk:"name"`
+	Icon       types.String `tfsdk:"icon"`
+	Note       types.String `tfsdk:"note"`
+	Options    types.String `tfsdk:"options"`
+}
+
+func (r *PanelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_panel"
+}
+
+func (r *PanelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Panel resource. Panels are the individual widgets placed on a `directus_dashboard` grid.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the panel (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the dashboard this panel belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The panel type, e.g. `metric`, `time-series`, `list`, `label` or `variable`.",
+				Required:            true,
+			},
+			"position_x": schema.Int64Attribute{
+				MarkdownDescription: "Horizontal position of the panel on the dashboard grid, starting at 1.",
+				Required:            true,
+			},
+			"position_y": schema.Int64Attribute{
+				MarkdownDescription: "Vertical position of the panel on the dashboard grid, starting at 1.",
+				Required:            true,
+			},
+			"width": schema.Int64Attribute{
+				MarkdownDescription: "Width of the panel in grid units.",
+				Required:            true,
+			},
+			"height": schema.Int64Attribute{
+				MarkdownDescription: "Height of the panel in grid units.",
+				Required:            true,
+			},
+			"show_header": schema.BoolAttribute{
+				MarkdownDescription: "Whether the panel header (name, icon and note) is shown. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the panel, shown in the header.",
+				Optional:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "The name of a Google Material Design Icon shown in the header.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "A description of the panel, shown in the header.",
+				Optional:            true,
+			},
+			"options": schema.StringAttribute{
+				MarkdownDescription: "JSON object of options for the panel type, e.g. the collection, aggregate function and filter of a metric.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the grid geometry and the options JSON.
+func (r *PanelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PanelResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	geometry := []struct {
+		name  string
+		value types.Int64
+	}{
+		{"position_x", data.PositionX},
+		{"position_y", data.PositionY},
+		{"width", data.Width},
+		{"height", data.Height},
+	}
+
+	for _, g := range geometry {
+		if !g.value.IsNull() && !g.value.IsUnknown() && g.value.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(g.name),
+				"Invalid Panel Geometry",
+				fmt.Sprintf("%s must be at least 1, got: %d", g.name, g.value.ValueInt64()),
+			)
+		}
+	}
+
+	if err := validateJSONString(data.Options); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("options"), "Invalid Panel Options", err.Error())
+	}
+}
+
+func (r *PanelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *PanelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PanelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data panelAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "panels", buildPanelInput(plan, true), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Panel",
+			"Could not create panel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+func (r *PanelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PanelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data panelAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "panels", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Panel",
+			"Could not read panel ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(state))...)
+}
+
+func (r *PanelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PanelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data panelAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "panels", plan.ID.ValueString(), buildPanelInput(plan, false), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Panel",
+			"Could not update panel ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+func (r *PanelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PanelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "panels", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Panel",
+			"Could not delete panel ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *PanelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// panelAPIResponse represents the API response for panel operations.
+type panelAPIResponse struct {
+	ID         string          `json:"id"`
+	Dashboard  string          `json:"dashboard"`
+	Type       string          `json:"type"`
+	PositionX  int64           `json:"position_x"`
+	PositionY  int64           `json:"position_y"`
+	Width      int64           `json:"width"`
+	Height     int64           `json:"height"`
+	ShowHeader bool            `json:"show_header"`
+	Name       string          `json:"name,omitempty"`
+	Icon       string          `json:"icon,omitempty"`
+	Note       string          `json:"note,omitempty"`
+	Options    json.RawMessage `json:"options,omitempty"`
+}
+
+// toModel converts panelAPIResponse to PanelResourceModel, keeping the prior
+// options string when it is semantically equal to the API value.
+func (p *panelAPIResponse) toModel(prior PanelResourceModel) *PanelResourceModel {
+	return &PanelResourceModel{
+		ID:         types.StringValue(p.ID),
+		Dashboard:  types.StringValue(p.Dashboard),
+		Type:       types.StringValue(p.Type),
+		PositionX:  types.Int64Value(p.PositionX),
+		PositionY:  types.Int64Value(p.PositionY),
+		Width:      types.Int64Value(p.Width),
+		Height:     types.Int64Value(p.Height),
+		ShowHeader: types.BoolValue(p.ShowHeader),
+		Name:       stringOrNull(p.Name),
+		Icon:       stringOrNull(p.Icon),
+		Note:       stringOrNull(p.Note),
+		Options:    jsonObjectValue(prior.Options, p.Options),
+	}
+}
+
+// buildPanelInput constructs the input from the resource model (used for both create and update)
+func buildPanelInput(data PanelResourceModel, isCreate bool) map[string]interface{} {
+	input := map[string]interface{}{
+		"type":       data.Type.ValueString(),
+		"position_x": data.PositionX.ValueInt64(),
+		"position_y": data.PositionY.ValueInt64(),
+		"width":      data.Width.ValueInt64(),
+		"height":     data.Height.ValueInt64(),
+	}
+
+	setBoolField(input, "show_header", data.ShowHeader)
+	setStringField(input, "icon", data.Icon)
+	setJSONField(input, "options", data.Options)
+
+	if isCreate {
+		input["dashboard"] = data.Dashboard.ValueString()
+		setStringField(input, "name", data.Name)
+		setStringField(input, "note", data.Note)
+	} else {
+		setNullableStringField(input, "name", data.Name)
+		setNullableStringField(input, "note", data.Note)
+		if data.Options.IsNull() {
+			input["options"] = nil
+		}
+	}
+
+	return input
+}
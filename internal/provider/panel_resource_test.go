@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestPanelResourceSchema(t *testing.T) {
+	r := &PanelResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "dashboard", "type", "position_x", "position_y", "width", "height", "show_header", "name", "icon", "note", "options"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	for _, attr := range []string{"dashboard", "type", "position_x", "position_y", "width", "height"} {
+		assert.True(t, schemaResp.Schema.Attributes[attr].IsRequired(), "%s should be required", attr)
+	}
+}
+
+func TestPanelResourceMetadata(t *testing.T) {
+	r := &PanelResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_panel", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newPanelModel() *PanelResourceModel {
+	return &PanelResourceModel{
+		ID:         types.StringNull(),
+		Dashboard:  types.StringValue("dash-1"),
+		Type:       types.StringValue("metric"),
+		PositionX:  types.Int64Value(1),
+		PositionY:  types.Int64Value(1),
+		Width:      types.Int64Value(12),
+		Height:     types.Int64Value(6),
+		ShowHeader: types.BoolValue(false),
+		Name:       types.StringNull(),
+		Icon:       types.StringNull(),
+		Note:       types.StringNull(),
+		Options:    types.StringNull(),
+	}
+}
+
+func TestPanelResource_ValidateConfig(t *testing.T) {
+	r := &PanelResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *PanelResourceModel)
+		expectErr bool
+	}{
+		{"valid", func(m *PanelResourceModel) {}, false},
+		{"valid options", func(m *PanelResourceModel) { m.Options = types.StringValue(`{"collection":"orders"}`) }, false},
+		{"zero position", func(m *PanelResourceModel) { m.PositionX = types.Int64Value(0) }, true},
+		{"negative height", func(m *PanelResourceModel) { m.Height = types.Int64Value(-2) }, true},
+		{"unknown width", func(m *PanelResourceModel) { m.Width = types.Int64Unknown() }, false},
+		{"invalid options", func(m *PanelResourceModel) { m.Options = types.StringValue("[1,") }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newPanelModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildPanelInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildPanelInput(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		model := newPanelModel()
+		model.Name = types.StringValue("Open orders")
+		model.Options = types.StringValue(`{"collection":"orders","function":"count"}`)
+
+		input := buildPanelInput(*model, true)
+
+		assert.Equal(t, "dash-1", input["dashboard"])
+		assert.Equal(t, "metric", input["type"])
+		assert.Equal(t, int64(12), input["width"])
+		assert.Equal(t, false, input["show_header"])
+		assert.Equal(t, "Open orders", input["name"])
+		assert.Equal(t, map[string]interface{}{"collection": "orders", "function": "count"}, input["options"])
+		assert.NotContains(t, input, "note")
+	})
+
+	t.Run("update clears removed fields", func(t *testing.T) {
+		input := buildPanelInput(*newPanelModel(), false)
+
+		assert.NotContains(t, input, "dashboard")
+		assert.Contains(t, input, "name")
+		assert.Nil(t, input["name"])
+		assert.Contains(t, input, "options")
+		assert.Nil(t, input["options"])
+	})
+}
+
+func TestPanelAPIResponseToModel(t *testing.T) {
+	prior := newPanelModel()
+	prior.Options = types.StringValue(`{"function": "count", "collection": "orders"}`)
+
+	model := (&panelAPIResponse{
+		ID: "panel-1", Dashboard: "dash-1", Type: "metric",
+		PositionX: 1, PositionY: 7, Width: 12, Height: 6, ShowHeader: true,
+		Name: "Open orders", Icon: "insert_chart",
+		Options: json.RawMessage(`{"collection":"orders","function":"count"}`),
+	}).toModel(*prior)
+
+	assert.Equal(t, "panel-1", model.ID.ValueString())
+	assert.Equal(t, int64(7), model.PositionY.ValueInt64())
+	assert.True(t, model.ShowHeader.ValueBool())
+	assert.True(t, model.Note.IsNull())
+	assert.Equal(t, prior.Options.ValueString(), model.Options.ValueString(), "semantically equal options should keep the prior value")
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestPanelResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/panels", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "dash-1", body["dashboard"])
+		assert.Equal(t, float64(6), body["height"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "panel-1", "dashboard": "dash-1", "type": "metric",
+				"position_x": 1, "position_y": 1, "width": 12, "height": 6, "show_header": false,
+				"name": nil, "icon": "insert_chart", "note": nil, "options": nil,
+			},
+		}), nil
+	})
+
+	r := &PanelResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newPanelModel()
+	model.ID = types.StringUnknown()
+	model.Icon = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result PanelResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "panel-1", result.ID.ValueString())
+	assert.Equal(t, "insert_chart", result.Icon.ValueString())
+	assert.True(t, result.Options.IsNull())
+}
+
+func TestPanelResource_Update(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/panels/panel-1", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, float64(13), body["position_x"])
+		assert.NotContains(t, body, "dashboard")
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "panel-1", "dashboard": "dash-1", "type": "metric",
+				"position_x": 13, "position_y": 1, "width": 12, "height": 6, "show_header": false,
+				"icon": "insert_chart",
+			},
+		}), nil
+	})
+
+	r := &PanelResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newPanelModel()
+	model.ID = types.StringValue("panel-1")
+	model.PositionX = types.Int64Value(13)
+	model.Icon = types.StringValue("insert_chart")
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+
+	var result PanelResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, int64(13), result.PositionX.ValueInt64())
+}
+
+func TestPanelResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/panels/panel-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &PanelResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newPanelModel()
+	model.ID = types.StringValue("panel-1")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
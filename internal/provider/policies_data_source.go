@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &PoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &PoliciesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &PoliciesDataSource{}
+)
+
+// NewPoliciesDataSource creates a new policies data source.
+func NewPoliciesDataSource() datasource.DataSource {
+	return &PoliciesDataSource{}
+}
+
+// PoliciesDataSource defines the data source implementation.
+type PoliciesDataSource struct {
+	client *client.Client
+}
+
+// PoliciesDataSourceModel describes the data source data model.
+type PoliciesDataSourceModel struct {
+	Filter   types.String            `tfsdk:"filter"`
+	Sort     types.List              `tfsdk:"sort"`
+	Limit    types.Int64             `tfsdk:"limit"`
+	Policies []PolicyDataSourceModel `tfsdk:"policies"`
+}
+
+func (d *PoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *PoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Directus access policies, optionally filtered, sorted and limited on the server.",
+
+		Attributes: listQueryAttributes("policies"),
+	}
+
+	resp.Schema.Attributes["policies"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching policies.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: policyDataSourceAttributes(),
+		},
+	}
+}
+
+// ValidateConfig checks the filter expression and limit.
+func (d *PoliciesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data PoliciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateJSONObject(data.Filter); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+	}
+	if err := validatePositiveInt64(data.Limit); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid Limit", err.Error())
+	}
+}
+
+func (d *PoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *PoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoliciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Data []policyAPIResponse `json:"data"`
+	}
+	params := listQueryParams(data.Filter, data.Sort, data.Limit)
+	params["fields"] = policyReadFields
+	if err := d.client.ListWithParams(ctx, "policies", params, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Policies",
+			fmt.Sprintf("Could not list policies: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Policies = make([]PolicyDataSourceModel, len(result.Data))
+	for i, policy := range result.Data {
+		data.Policies[i] = *policy.toDataSourceModel()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
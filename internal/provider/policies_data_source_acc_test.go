@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoliciesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_policy" "test" {
+  name       = "AccTest Policies List"
+  app_access = true
+  ip_access  = ["10.0.0.0/8"]
+}
+
+data "directus_policies" "test" {
+  filter = jsonencode({ name = { _eq = "AccTest Policies List" } })
+
+  depends_on = [directus_policy.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.directus_policies.test", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.directus_policies.test", "policies.0.id", "directus_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.directus_policies.test", "policies.0.app_access", "true"),
+					resource.TestCheckResourceAttr("data.directus_policies.test", "policies.0.ip_access.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoliciesDataSource_Metadata(t *testing.T) {
+	d := &PoliciesDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_policies", resp.TypeName)
+}
+
+func TestPoliciesDataSource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/policies", req.URL.Path)
+		assert.Equal(t, policyReadFields, req.URL.Query().Get("fields"))
+		assert.Equal(t, `{"admin_access":{"_eq":false}}`, req.URL.Query().Get("filter"))
+		assert.Equal(t, "2", req.URL.Query().Get("limit"))
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []interface{}{
+				map[string]interface{}{"id": "public-1", "name": publicPolicyName},
+				map[string]interface{}{
+					"id":          "policy-1",
+					"name":        "Editors",
+					"ip_access":   []string{"10.0.0.1"},
+					"roles":       []interface{}{map[string]interface{}{"role": "role-1"}},
+					"users":       []interface{}{},
+					"permissions": []interface{}{map[string]interface{}{"id": 1}, map[string]interface{}{"id": 2}},
+				},
+			},
+		}), nil
+	})
+
+	d := &PoliciesDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, &PoliciesDataSourceModel{
+		Filter: types.StringValue(`{"admin_access":{"_eq":false}}`),
+		Sort:   types.ListNull(types.StringType),
+		Limit:  types.Int64Value(2),
+	})}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result PoliciesDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	require.Len(t, result.Policies, 2)
+	assert.True(t, result.Policies[0].Public.ValueBool())
+	assert.False(t, result.Policies[1].Public.ValueBool())
+	assert.Equal(t, []string{"10.0.0.1"}, stringSetValues(result.Policies[1].IPAccess))
+	assert.Equal(t, []string{"role-1"}, stringSetValues(result.Policies[1].RoleIDs))
+	assert.Equal(t, int64(2), result.Policies[1].PermissionCount.ValueInt64())
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+// publicPolicyName is the translation key Directus uses as the name of the built-in
+// public policy, which applies to unauthenticated requests.
+const publicPolicyName = "$t:public_label"
+
+var (
+	_ datasource.DataSource                   = &PolicyDataSource{}
+	_ datasource.DataSourceWithConfigure      = &PolicyDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &PolicyDataSource{}
+)
+
+// NewPolicyDataSource creates a new policy data source.
+func NewPolicyDataSource() datasource.DataSource {
+	return &PolicyDataSource{}
+}
+
+// PolicyDataSource defines the data source implementation.
+type PolicyDataSource struct {
+	client *client.Client
+}
+
+// PolicyDataSourceModel describes the data source data model.
+type PolicyDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Public          types.Bool   `tfsdk:"public"`
+	Icon            types.String `tfsdk:"icon"`
+	Description     types.String `tfsdk:"description"`
+	IPAccess        types.Set    `tfsdk:"ip_access"`
+	EnforceTFA      types.Bool   `tfsdk:"enforce_tfa"`
+	AdminAccess     types.Bool   `tfsdk:"admin_access"`
+	AppAccess       types.Bool   `tfsdk:"app_access"`
+	RoleIDs         types.Set    `tfsdk:"role_ids"`
+	UserIDs         types.Set    `tfsdk:"user_ids"`
+	PermissionCount types.Int64  `tfsdk:"permission_count"`
+}
+
+func (d *PolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (d *PolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Directus access policy by `id`, exact `name`, or as the built-in public policy.",
+
+		Attributes: policyDataSourceAttributes(),
+	}
+
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The UUID of the policy. Exactly one of `id`, `name` or `public = true` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The exact name of the policy. The lookup fails if no policy or more than one policy has this name.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["public"] = schema.BoolAttribute{
+		MarkdownDescription: "Set to `true` to look up the built-in public policy, whose name is the translation key `" + publicPolicyName + "`.",
+		Optional:            true,
+		Computed:            true,
+	}
+}
+
+// policyDataSourceAttributes returns the computed attributes describing a policy, shared
+// by the directus_policy and directus_policies data sources.
+func policyDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The UUID of the policy.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the policy.",
+			Computed:            true,
+		},
+		"public": schema.BoolAttribute{
+			MarkdownDescription: "Whether the policy is the built-in public policy.",
+			Computed:            true,
+		},
+		"icon": schema.StringAttribute{
+			MarkdownDescription: "The name of the Google Material Design Icon assigned to the policy.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the policy.",
+			Computed:            true,
+		},
+		"ip_access": schema.SetAttribute{
+			MarkdownDescription: "IP addresses, IP ranges, and CIDR blocks the policy is restricted to.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"enforce_tfa": schema.BoolAttribute{
+			MarkdownDescription: "Whether Two-Factor Authentication is required for users with the policy.",
+			Computed:            true,
+		},
+		"admin_access": schema.BoolAttribute{
+			MarkdownDescription: "Whether the policy grants full admin access.",
+			Computed:            true,
+		},
+		"app_access": schema.BoolAttribute{
+			MarkdownDescription: "Whether the policy grants access to the Data Studio.",
+			Computed:            true,
+		},
+		"role_ids": schema.SetAttribute{
+			MarkdownDescription: "UUIDs of the roles the policy is attached to.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"user_ids": schema.SetAttribute{
+			MarkdownDescription: "UUIDs of the users the policy is attached to directly.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"permission_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of permissions that belong to the policy.",
+			Computed:            true,
+		},
+	}
+}
+
+// ValidateConfig checks that the policy is looked up by exactly one of id, name or public.
+func (d *PolicyDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data PolicyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Name.IsUnknown() || data.Public.IsUnknown() {
+		return
+	}
+	lookups := 0
+	if !data.ID.IsNull() {
+		lookups++
+	}
+	if !data.Name.IsNull() {
+		lookups++
+	}
+	if data.Public.ValueBool() {
+		lookups++
+	}
+	if lookups != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Policy Lookup",
+			"Exactly one of id, name or public = true must be set.",
+		)
+	}
+}
+
+func (d *PolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy policyAPIResponse
+	if !data.ID.IsNull() {
+		var result struct {
+			Data policyAPIResponse `json:"data"`
+		}
+		if err := d.client.GetWithParams(ctx, "policies", data.ID.ValueString(), map[string]string{"fields": policyReadFields}, &result); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Policy",
+				fmt.Sprintf("Could not read policy %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		policy = result.Data
+	} else {
+		name := data.Name.ValueString()
+		if data.Public.ValueBool() {
+			name = publicPolicyName
+		}
+
+		var result struct {
+			Data []policyAPIResponse `json:"data"`
+		}
+		params := map[string]string{
+			"filter[name][_eq]": name,
+			"fields":            policyReadFields,
+			"limit":             "-1",
+		}
+		if err := d.client.ListWithParams(ctx, "policies", params, &result); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Policy",
+				fmt.Sprintf("Could not look up policy %q: %s", name, err.Error()),
+			)
+			return
+		}
+
+		switch len(result.Data) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Policy Not Found",
+				fmt.Sprintf("No policy is named %q.", name),
+			)
+			return
+		case 1:
+			policy = result.Data[0]
+		default:
+			ids := make([]string, len(result.Data))
+			for i, p := range result.Data {
+				ids[i] = p.ID
+			}
+			resp.Diagnostics.AddError(
+				"Ambiguous Policy Name",
+				fmt.Sprintf("%d policies are named %q (%s). Look the policy up by id instead.",
+					len(ids), name, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy.toDataSourceModel())...)
+}
+
+// toDataSourceModel converts policyAPIResponse to PolicyDataSourceModel.
+func (p *policyAPIResponse) toDataSourceModel() *PolicyDataSourceModel {
+	model := p.toModel(PolicyResourceModel{})
+	return &PolicyDataSourceModel{
+		ID:              model.ID,
+		Name:            model.Name,
+		Public:          types.BoolValue(p.Name == publicPolicyName),
+		Icon:            model.Icon,
+		Description:     model.Description,
+		IPAccess:        model.IPAccess,
+		EnforceTFA:      model.EnforceTFA,
+		AdminAccess:     model.AdminAccess,
+		AppAccess:       model.AppAccess,
+		RoleIDs:         model.RoleIDs,
+		UserIDs:         model.UserIDs,
+		PermissionCount: model.PermissionCount,
+	}
+}
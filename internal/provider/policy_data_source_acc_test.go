@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_policy" "lookup" {
+  name       = "AccTest Policy Lookup"
+  app_access = true
+}
+
+data "directus_policy" "by_name" {
+  name = directus_policy.lookup.name
+}
+
+data "directus_policy" "by_id" {
+  id = directus_policy.lookup.id
+}
+
+data "directus_policy" "public" {
+  public = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.directus_policy.by_name", "id", "directus_policy.lookup", "id"),
+					resource.TestCheckResourceAttr("data.directus_policy.by_name", "app_access", "true"),
+					resource.TestCheckResourceAttr("data.directus_policy.by_name", "public", "false"),
+					resource.TestCheckResourceAttr("data.directus_policy.by_id", "name", "AccTest Policy Lookup"),
+					resource.TestCheckResourceAttrSet("data.directus_policy.public", "id"),
+					resource.TestCheckResourceAttr("data.directus_policy.public", "name", publicPolicyName),
+					resource.TestCheckResourceAttr("data.directus_policy.public", "admin_access", "false"),
+				),
+			},
+		},
+	})
+}
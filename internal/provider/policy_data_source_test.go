@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPolicyLookupModel returns a data source config model that looks a policy up by
+// id, name, or as the public policy.
+func newPolicyLookupModel(id, name string, public types.Bool) *PolicyDataSourceModel {
+	return &PolicyDataSourceModel{
+		ID:       stringOrNull(id),
+		Name:     stringOrNull(name),
+		Public:   public,
+		IPAccess: types.SetNull(types.StringType),
+		RoleIDs:  types.SetNull(types.StringType),
+		UserIDs:  types.SetNull(types.StringType),
+	}
+}
+
+// readPolicyDataSource runs Read for the given lookup and returns the response.
+func readPolicyDataSource(t *testing.T, d *PolicyDataSource, model *PolicyDataSourceModel) *fwdatasource.ReadResponse {
+	t.Helper()
+	schema := getDataSourceSchema(t, d)
+
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, model)}, resp)
+	return resp
+}
+
+func TestPolicyDataSource_Metadata(t *testing.T) {
+	d := &PolicyDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_policy", resp.TypeName)
+}
+
+func TestPolicyDataSource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		policyName string
+		public     types.Bool
+		expectErr  bool
+	}{
+		{"by id", "policy-1", "", types.BoolNull(), false},
+		{"by name", "", "Editors", types.BoolNull(), false},
+		{"public", "", "", types.BoolValue(true), false},
+		{"name with public false", "", "Editors", types.BoolValue(false), false},
+		{"nothing", "", "", types.BoolNull(), true},
+		{"public false only", "", "", types.BoolValue(false), true},
+		{"id and name", "policy-1", "Editors", types.BoolNull(), true},
+		{"name and public", "", "Editors", types.BoolValue(true), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &PolicyDataSource{}
+			schema := getDataSourceSchema(t, d)
+
+			resp := &fwdatasource.ValidateConfigResponse{}
+			d.ValidateConfig(context.Background(), fwdatasource.ValidateConfigRequest{
+				Config: makeDataSourceConfig(t, schema, newPolicyLookupModel(tt.id, tt.policyName, tt.public)),
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestPolicyDataSource_Read_ByID(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/policies/policy-1", req.URL.Path)
+		assert.Equal(t, policyReadFields, req.URL.Query().Get("fields"))
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":           "policy-1",
+				"name":         "Editors",
+				"app_access":   true,
+				"ip_access":    []string{"10.0.0.0/8"},
+				"roles":        []interface{}{map[string]interface{}{"role": "role-1"}},
+				"permissions":  []interface{}{map[string]interface{}{"id": 1}},
+				"admin_access": false,
+			},
+		}), nil
+	})
+
+	resp := readPolicyDataSource(t, &PolicyDataSource{client: mockClient}, newPolicyLookupModel("policy-1", "", types.BoolNull()))
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result PolicyDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "Editors", result.Name.ValueString())
+	assert.False(t, result.Public.ValueBool())
+	assert.True(t, result.AppAccess.ValueBool())
+	assert.Equal(t, []string{"10.0.0.0/8"}, stringSetValues(result.IPAccess))
+	assert.Equal(t, []string{"role-1"}, stringSetValues(result.RoleIDs))
+	assert.Equal(t, int64(1), result.PermissionCount.ValueInt64())
+}
+
+func TestPolicyDataSource_Read_Public(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/policies", req.URL.Path)
+		assert.Equal(t, publicPolicyName, req.URL.Query().Get("filter[name][_eq]"))
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []interface{}{map[string]interface{}{"id": "public-1", "name": publicPolicyName}},
+		}), nil
+	})
+
+	resp := readPolicyDataSource(t, &PolicyDataSource{client: mockClient}, newPolicyLookupModel("", "", types.BoolValue(true)))
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result PolicyDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "public-1", result.ID.ValueString())
+	assert.Equal(t, publicPolicyName, result.Name.ValueString())
+	assert.True(t, result.Public.ValueBool())
+}
+
+func TestPolicyDataSource_Read_ByName(t *testing.T) {
+	tests := []struct {
+		name      string
+		policies  []interface{}
+		expectErr string
+	}{
+		{"single match", []interface{}{map[string]interface{}{"id": "policy-1", "name": "Editors"}}, ""},
+		{"no match", []interface{}{}, "Policy Not Found"},
+		{"ambiguous", []interface{}{
+			map[string]interface{}{"id": "policy-1", "name": "Editors"},
+			map[string]interface{}{"id": "policy-2", "name": "Editors"},
+		}, "Ambiguous Policy Name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "Editors", req.URL.Query().Get("filter[name][_eq]"))
+				return mockJSONResponse(200, map[string]interface{}{"data": tt.policies}), nil
+			})
+
+			resp := readPolicyDataSource(t, &PolicyDataSource{client: mockClient}, newPolicyLookupModel("", "Editors", types.BoolNull()))
+
+			if tt.expectErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectErr, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+			var result PolicyDataSourceModel
+			resp.State.Get(context.Background(), &result)
+			assert.Equal(t, "policy-1", result.ID.ValueString())
+			assert.True(t, result.RoleIDs.IsNull())
+		})
+	}
+}
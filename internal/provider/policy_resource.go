@@ -2,14 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -17,11 +23,18 @@ import (
 )
 
 var (
-	_ resource.Resource                = &PolicyResource{}
-	_ resource.ResourceWithConfigure   = &PolicyResource{}
-	_ resource.ResourceWithImportState = &PolicyResource{}
+	_ resource.Resource                   = &PolicyResource{}
+	_ resource.ResourceWithConfigure      = &PolicyResource{}
+	_ resource.ResourceWithImportState    = &PolicyResource{}
+	_ resource.ResourceWithValidateConfig = &PolicyResource{}
+	_ resource.ResourceWithUpgradeState   = &PolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &PolicyResource{}
 )
 
+// policyReadFields expands the roles, users and permissions relations of a policy so
+// that they can be read in a single request.
+const policyReadFields = "*,roles.role,users.user,permissions.id"
+
 // NewPolicyResource creates a new Policy resource.
 func NewPolicyResource() resource.Resource {
 	return &PolicyResource{}
@@ -38,10 +51,15 @@ type PolicyResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Icon        types.String `tfsdk:"icon"`
 	Description types.String `tfsdk:"description"`
-	IPAccess    types.String `tfsdk:"ip_access"`
+	IPAccess    types.Set    `tfsdk:"ip_access"`
 	EnforceTFA  types.Bool   `tfsdk:"enforce_tfa"`
 	AdminAccess types.Bool   `tfsdk:"admin_access"`
 	AppAccess   types.Bool   `tfsdk:"app_access"`
+
+	// Computed relationships
+	RoleIDs         types.Set   `tfsdk:"role_ids"`
+	UserIDs         types.Set   `tfsdk:"user_ids"`
+	PermissionCount types.Int64 `tfsdk:"permission_count"`
 }
 
 // Metadata returns the resource type name.
@@ -51,7 +69,42 @@ func (r *PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 // Schema defines the schema for the resource.
 func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
+	resp.Schema = policyResourceSchemaV0()
+	resp.Schema.Version = 1
+	resp.Schema.Attributes["ip_access"] = schema.SetAttribute{
+		Description: "IP addresses, IP ranges (`a-b`), and CIDR blocks that this policy applies to. Allows you to configure an IP allowlist. Entries are validated and compared in normalized form.",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+	resp.Schema.Attributes["role_ids"] = schema.SetAttribute{
+		Description: "UUIDs of the roles this policy is attached to, including attachments made outside Terraform.",
+		Computed:    true,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema.Attributes["user_ids"] = schema.SetAttribute{
+		Description: "UUIDs of the users this policy is attached to directly, including attachments made outside Terraform.",
+		Computed:    true,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema.Attributes["permission_count"] = schema.Int64Attribute{
+		Description: "The number of permissions that belong to this policy.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// policyResourceSchemaV0 is the schema before ip_access became a set. It is also the
+// base of the current schema, which only replaces ip_access.
+func policyResourceSchemaV0() schema.Schema {
+	return schema.Schema{
 		Description: "Manages a Directus access policy. Policies are composable units that define a specific set of access permissions.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -103,6 +156,85 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// ValidateConfig checks that every ip_access entry is an IP address, CIDR block, or range.
+func (r *PolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, entry := range stringSetValues(data.IPAccess) {
+		if _, err := normalizeIPAccessEntry(entry); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ip_access"), "Invalid IP Access Entry", err.Error())
+		}
+	}
+}
+
+// UpgradeState migrates the comma-separated ip_access string of schema version 0 to a set.
+func (r *PolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := policyResourceSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior policyResourceModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := PolicyResourceModel{
+					ID:          prior.ID,
+					Name:        prior.Name,
+					Icon:        prior.Icon,
+					Description: prior.Description,
+					IPAccess:    stringSetOrNull(splitIPAccessCSV(prior.IPAccess.ValueString())),
+					EnforceTFA:  prior.EnforceTFA,
+					AdminAccess: prior.AdminAccess,
+					AppAccess:   prior.AppAccess,
+
+					RoleIDs:         types.SetNull(types.StringType),
+					UserIDs:         types.SetNull(types.StringType),
+					PermissionCount: types.Int64Null(),
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
+
+// ModifyPlan warns when a policy that is attached to roles or users is destroyed. The
+// plans of other resources are not visible here, so the warning is also shown when the
+// same plan detaches the policy or destroys the roles and users.
+func (r *PolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state PolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, users := len(state.RoleIDs.Elements()), len(state.UserIDs.Elements())
+	if roles == 0 && users == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Destroying Attached Policy",
+		fmt.Sprintf("Policy %s was attached to %d role(s) and %d user(s) when last refreshed. Destroying it removes "+
+			"these attachments, and roles and users that keep existing lose the access it grants. "+
+			"This can be ignored if the same plan detaches the policy or destroys those roles and users.",
+			state.Name.ValueString(), roles, users),
+	)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -154,7 +286,17 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+	// The create response does not expand the relations, so the policy is read back.
+	policy, err := r.readPolicy(ctx, response.Data.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Policy",
+			fmt.Sprintf("Could not read policy %s after creating it: %s", response.Data.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy.toModel(plan))...)
 }
 
 // Read reads the policy.
@@ -166,11 +308,8 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	var response struct {
-		Data policyAPIResponse `json:"data"`
-	}
-
-	if err := r.client.Get(ctx, "policies", state.ID.ValueString(), &response); err != nil {
+	policy, err := r.readPolicy(ctx, state.ID.ValueString())
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Policy",
 			fmt.Sprintf("Could not read policy %s: %s", state.ID.ValueString(), err.Error()),
@@ -178,7 +317,7 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy.toModel(state))...)
 }
 
 // Update updates the policy.
@@ -196,6 +335,9 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	setStringField(reqBody, "icon", plan.Icon)
 	setStringField(reqBody, "description", plan.Description)
 	setIPAccessField(reqBody, plan.IPAccess)
+	if plan.IPAccess.IsNull() {
+		reqBody["ip_access"] = nil
+	}
 	setBoolField(reqBody, "enforce_tfa", plan.EnforceTFA)
 	setBoolField(reqBody, "admin_access", plan.AdminAccess)
 	setBoolField(reqBody, "app_access", plan.AppAccess)
@@ -213,7 +355,17 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+	// The update response does not expand the relations, so the policy is read back.
+	policy, err := r.readPolicy(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Policy",
+			fmt.Sprintf("Could not read policy %s after updating it: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy.toModel(plan))...)
 }
 
 // Delete deletes the policy.
@@ -234,6 +386,17 @@ func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// readPolicy reads a policy with its roles, users and permissions relations expanded.
+func (r *PolicyResource) readPolicy(ctx context.Context, id string) (*policyAPIResponse, error) {
+	var response struct {
+		Data policyAPIResponse `json:"data"`
+	}
+	if err := r.client.GetWithParams(ctx, "policies", id, map[string]string{"fields": policyReadFields}, &response); err != nil {
+		return nil, err
+	}
+	return &response.Data, nil
+}
+
 // ImportState imports the policy by ID.
 func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
@@ -249,16 +412,82 @@ type policyAPIResponse struct {
 	EnforceTFA  bool     `json:"enforce_tfa,omitempty"`
 	AdminAccess bool     `json:"admin_access,omitempty"`
 	AppAccess   bool     `json:"app_access,omitempty"`
+
+	// Relations, expanded by policyReadFields
+	Roles       []policyAccessRef `json:"roles,omitempty"`
+	Users       []policyAccessRef `json:"users,omitempty"`
+	Permissions []json.RawMessage `json:"permissions,omitempty"`
+}
+
+// policyAccessRef is a directus_access record of a policy's roles or users relation.
+// Unless the relation is expanded, Directus returns only the access record IDs, which
+// leave the ref unexpanded.
+type policyAccessRef struct {
+	Role     string
+	User     string
+	expanded bool
+}
+
+// UnmarshalJSON decodes an expanded access record and ignores plain IDs.
+func (a *policyAccessRef) UnmarshalJSON(data []byte) error {
+	var record struct {
+		Role *string `json:"role"`
+		User *string `json:"user"`
+	}
+	if len(data) == 0 || data[0] != '{' {
+		return nil
+	}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return err
+	}
+	if record.Role != nil {
+		a.Role = *record.Role
+	}
+	if record.User != nil {
+		a.User = *record.User
+	}
+	a.expanded = true
+	return nil
+}
+
+// policyAccessIDs collects the distinct, sorted IDs picked from the access records. It
+// returns false when the relation was not expanded, so the IDs are unknown.
+func policyAccessIDs(refs []policyAccessRef, id func(policyAccessRef) string) ([]string, bool) {
+	seen := make(map[string]bool, len(refs))
+	var ids []string
+	for _, ref := range refs {
+		if !ref.expanded {
+			return nil, false
+		}
+		if v := id(ref); v != "" && !seen[v] {
+			seen[v] = true
+			ids = append(ids, v)
+		}
+	}
+	sort.Strings(ids)
+	return ids, true
 }
 
-// toModel converts policyAPIResponse to PolicyResourceModel
-func (p *policyAPIResponse) toModel() *PolicyResourceModel {
-	// Convert ip_access array from API to comma-separated string for Terraform
-	var ipAccess types.String
-	if len(p.IPAccess) > 0 {
-		ipAccess = types.StringValue(strings.Join(p.IPAccess, ","))
-	} else {
-		ipAccess = types.StringNull()
+// toModel converts policyAPIResponse to PolicyResourceModel. The prior ip_access set is
+// kept when it normalizes to the same entries the API returned, so formatting
+// differences in the configuration do not show up as drift.
+func (p *policyAPIResponse) toModel(prior PolicyResourceModel) *PolicyResourceModel {
+	ipAccess := stringSetOrNull(p.IPAccess)
+	if !prior.IPAccess.IsNull() && slices.Equal(normalizeIPAccess(stringSetValues(prior.IPAccess)), normalizeIPAccess(p.IPAccess)) {
+		ipAccess = prior.IPAccess
+	}
+
+	// Keep the prior values if a relation was not expanded, e.g. in a write response.
+	roleIDs, userIDs := types.SetNull(types.StringType), types.SetNull(types.StringType)
+	if ids, ok := policyAccessIDs(p.Roles, func(a policyAccessRef) string { return a.Role }); ok {
+		roleIDs = stringSetOrNull(ids)
+	} else if !prior.RoleIDs.IsNull() && !prior.RoleIDs.IsUnknown() {
+		roleIDs = prior.RoleIDs
+	}
+	if ids, ok := policyAccessIDs(p.Users, func(a policyAccessRef) string { return a.User }); ok {
+		userIDs = stringSetOrNull(ids)
+	} else if !prior.UserIDs.IsNull() && !prior.UserIDs.IsUnknown() {
+		userIDs = prior.UserIDs
 	}
 
 	return &PolicyResourceModel{
@@ -270,27 +499,96 @@ func (p *policyAPIResponse) toModel() *PolicyResourceModel {
 		EnforceTFA:  types.BoolValue(p.EnforceTFA),
 		AdminAccess: types.BoolValue(p.AdminAccess),
 		AppAccess:   types.BoolValue(p.AppAccess),
+
+		RoleIDs:         roleIDs,
+		UserIDs:         userIDs,
+		PermissionCount: types.Int64Value(int64(len(p.Permissions))),
 	}
 }
 
-// setIPAccessField converts the CSV ip_access string to a JSON array for the Directus API.
-func setIPAccessField(input map[string]interface{}, value types.String) {
-	if value.IsNull() || value.IsUnknown() {
-		return
-	}
-	csv := value.ValueString()
-	if csv == "" {
-		return
+// policyResourceModelV0 is the model of schema version 0, where ip_access was a CSV string.
+type policyResourceModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	IPAccess    types.String `tfsdk:"ip_access"`
+	EnforceTFA  types.Bool   `tfsdk:"enforce_tfa"`
+	AdminAccess types.Bool   `tfsdk:"admin_access"`
+	AppAccess   types.Bool   `tfsdk:"app_access"`
+}
+
+// setIPAccessField adds the normalized ip_access entries to the input map as a JSON array.
+func setIPAccessField(input map[string]interface{}, value types.Set) {
+	if entries := normalizeIPAccess(stringSetValues(value)); len(entries) > 0 {
+		input["ip_access"] = entries
 	}
-	parts := strings.Split(csv, ",")
-	trimmed := make([]string, 0, len(parts))
-	for _, p := range parts {
-		if s := strings.TrimSpace(p); s != "" {
-			trimmed = append(trimmed, s)
+}
+
+// splitIPAccessCSV splits the comma-separated ip_access format of schema version 0.
+func splitIPAccessCSV(csv string) []string {
+	var entries []string
+	for _, part := range strings.Split(csv, ",") {
+		if s := strings.TrimSpace(part); s != "" {
+			entries = append(entries, s)
 		}
 	}
-	if len(trimmed) > 0 {
-		input["ip_access"] = trimmed
+	return entries
+}
+
+// normalizeIPAccess normalizes each entry and returns them sorted without duplicates.
+// Entries that cannot be parsed are kept as trimmed strings; ValidateConfig reports them.
+func normalizeIPAccess(entries []string) []string {
+	seen := make(map[string]bool, len(entries))
+	normalized := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		n, err := normalizeIPAccessEntry(entry)
+		if err != nil {
+			n = strings.TrimSpace(entry)
+		}
+		if n != "" && !seen[n] {
+			seen[n] = true
+			normalized = append(normalized, n)
+		}
 	}
+	sort.Strings(normalized)
+	return normalized
 }
 
+// normalizeIPAccessEntry parses an IP address, CIDR block, or `a-b` range and returns
+// its canonical form. CIDR blocks are masked to their network address.
+func normalizeIPAccessEntry(entry string) (string, error) {
+	entry = strings.TrimSpace(entry)
+
+	if from, to, ok := strings.Cut(entry, "-"); ok {
+		start, err := netip.ParseAddr(strings.TrimSpace(from))
+		if err != nil {
+			return "", fmt.Errorf("invalid start of IP range %q: %w", entry, err)
+		}
+		end, err := netip.ParseAddr(strings.TrimSpace(to))
+		if err != nil {
+			return "", fmt.Errorf("invalid end of IP range %q: %w", entry, err)
+		}
+		if start.Is4() != end.Is4() {
+			return "", fmt.Errorf("IP range %q mixes IPv4 and IPv6 addresses", entry)
+		}
+		if end.Less(start) {
+			return "", fmt.Errorf("IP range %q ends before it starts", entry)
+		}
+		return start.String() + "-" + end.String(), nil
+	}
+
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return "", fmt.Errorf("invalid CIDR block %q: %w", entry, err)
+		}
+		return prefix.Masked().String(), nil
+	}
+
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return "", fmt.Errorf("invalid IP address %q: %w", entry, err)
+	}
+	return addr.String(), nil
+}
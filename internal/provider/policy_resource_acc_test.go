@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -59,7 +60,7 @@ resource "directus_policy" "full" {
   admin_access = true
   app_access   = true
   enforce_tfa  = true
-  ip_access    = "10.0.0.0/8,192.168.1.0/24"
+  ip_access    = ["10.0.0.0/8", "192.168.1.0/24"]
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -70,7 +71,9 @@ resource "directus_policy" "full" {
 					resource.TestCheckResourceAttr("directus_policy.full", "admin_access", "true"),
 					resource.TestCheckResourceAttr("directus_policy.full", "app_access", "true"),
 					resource.TestCheckResourceAttr("directus_policy.full", "enforce_tfa", "true"),
-					resource.TestCheckResourceAttr("directus_policy.full", "ip_access", "10.0.0.0/8,192.168.1.0/24"),
+					resource.TestCheckResourceAttr("directus_policy.full", "ip_access.#", "2"),
+					resource.TestCheckTypeSetElemAttr("directus_policy.full", "ip_access.*", "10.0.0.0/8"),
+					resource.TestCheckTypeSetElemAttr("directus_policy.full", "ip_access.*", "192.168.1.0/24"),
 				),
 			},
 			// ImportState
@@ -125,12 +128,13 @@ func TestAccPolicy_ipAccessLifecycle(t *testing.T) {
 				Config: testAccProviderConfig() + `
 resource "directus_policy" "ip_test" {
   name       = "AccTest IP Policy"
-  ip_access  = "10.0.0.0/8"
+  ip_access  = ["10.0.0.0/8"]
   app_access = true
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("directus_policy.ip_test", "ip_access", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("directus_policy.ip_test", "ip_access.#", "1"),
+					resource.TestCheckTypeSetElemAttr("directus_policy.ip_test", "ip_access.*", "10.0.0.0/8"),
 				),
 			},
 			// Update ip_access to multiple ranges
@@ -138,12 +142,13 @@ resource "directus_policy" "ip_test" {
 				Config: testAccProviderConfig() + `
 resource "directus_policy" "ip_test" {
   name       = "AccTest IP Policy"
-  ip_access  = "10.0.0.0/8,192.168.0.0/16,172.16.0.0/12"
+  ip_access  = ["10.0.0.0/8", "192.168.0.0/16", "172.16.0.1-172.16.0.20"]
   app_access = true
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("directus_policy.ip_test", "ip_access", "10.0.0.0/8,192.168.0.0/16,172.16.0.0/12"),
+					resource.TestCheckResourceAttr("directus_policy.ip_test", "ip_access.#", "3"),
+					resource.TestCheckTypeSetElemAttr("directus_policy.ip_test", "ip_access.*", "172.16.0.1-172.16.0.20"),
 				),
 			},
 			// Import with ip_access set
@@ -152,6 +157,100 @@ resource "directus_policy" "ip_test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Entries that only differ in formatting from what Directus stores do not drift
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_policy" "ip_test" {
+  name       = "AccTest IP Policy"
+  ip_access  = ["10.1.2.3/8", "172.16.0.1 - 172.16.0.20"]
+  app_access = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("directus_policy.ip_test", "ip_access.*", "10.1.2.3/8"),
+				),
+			},
+			// Remove ip_access
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_policy" "ip_test" {
+  name       = "AccTest IP Policy"
+  app_access = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("directus_policy.ip_test", "ip_access.#"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccPolicy_relationships verifies that role_ids, user_ids and permission_count
+// reflect where a policy is in use.
+func TestAccPolicy_relationships(t *testing.T) {
+	const config = `
+resource "directus_policy" "shared" {
+  name = "AccTest Shared Policy"
+}
+
+resource "directus_role" "shared" {
+  name = "AccTest Shared Policy Role"
+}
+
+resource "directus_role_policies_attachment" "shared" {
+  role_id    = directus_role.shared.id
+  policy_ids = [directus_policy.shared.id]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_policy", "policies"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("directus_policy.shared", "role_ids.#"),
+					resource.TestCheckResourceAttr("directus_policy.shared", "permission_count", "0"),
+				),
+			},
+			// The attachment is only visible to the policy after a refresh
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_policy.shared", "role_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("directus_policy.shared", "role_ids.*", "directus_role.shared", "id"),
+					resource.TestCheckNoResourceAttr("directus_policy.shared", "user_ids.#"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "directus_policy.shared",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccPolicy_invalidIPAccess verifies that malformed ip_access entries fail validation.
+func TestAccPolicy_invalidIPAccess(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_policy" "invalid_ip" {
+  name      = "AccTest Invalid IP Policy"
+  ip_access = ["10.0.0.0/8", "10.0.0.0/40"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid IP Access Entry`),
+			},
 		},
 	})
 }
@@ -269,7 +368,7 @@ resource "directus_policy" "update" {
   app_access   = true
   admin_access = true
   enforce_tfa  = true
-  ip_access    = "172.16.0.0/12"
+  ip_access    = ["172.16.0.0/12"]
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -278,7 +377,7 @@ resource "directus_policy" "update" {
 					resource.TestCheckResourceAttr("directus_policy.update", "icon", "security"),
 					resource.TestCheckResourceAttr("directus_policy.update", "admin_access", "true"),
 					resource.TestCheckResourceAttr("directus_policy.update", "enforce_tfa", "true"),
-					resource.TestCheckResourceAttr("directus_policy.update", "ip_access", "172.16.0.0/12"),
+					resource.TestCheckTypeSetElemAttr("directus_policy.update", "ip_access.*", "172.16.0.0/12"),
 				),
 			},
 			// ImportState after update
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
 
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -95,7 +97,7 @@ func TestPolicyAPIResponseToModel(t *testing.T) {
 			ID:   "uuid-1",
 			Name: "My Policy",
 		}
-		model := resp.toModel()
+		model := resp.toModel(PolicyResourceModel{})
 
 		assert.Equal(t, "uuid-1", model.ID.ValueString())
 		assert.Equal(t, "My Policy", model.Name.ValueString())
@@ -118,13 +120,13 @@ func TestPolicyAPIResponseToModel(t *testing.T) {
 			AdminAccess: true,
 			AppAccess:   true,
 		}
-		model := resp.toModel()
+		model := resp.toModel(PolicyResourceModel{})
 
 		assert.Equal(t, "uuid-2", model.ID.ValueString())
 		assert.Equal(t, "Admin Policy", model.Name.ValueString())
 		assert.Equal(t, "shield", model.Icon.ValueString())
 		assert.Equal(t, "Full admin access", model.Description.ValueString())
-		assert.Equal(t, "192.168.1.0/24", model.IPAccess.ValueString())
+		assert.Equal(t, []string{"192.168.1.0/24"}, stringSetValues(model.IPAccess))
 		assert.True(t, model.EnforceTFA.ValueBool())
 		assert.True(t, model.AdminAccess.ValueBool())
 		assert.True(t, model.AppAccess.ValueBool())
@@ -136,7 +138,7 @@ func TestPolicyAPIResponseToModel(t *testing.T) {
 			Name:      "App Only",
 			AppAccess: true,
 		}
-		model := resp.toModel()
+		model := resp.toModel(PolicyResourceModel{})
 
 		assert.Equal(t, "uuid-3", model.ID.ValueString())
 		assert.True(t, model.Icon.IsNull())
@@ -146,6 +148,22 @@ func TestPolicyAPIResponseToModel(t *testing.T) {
 		assert.False(t, model.AdminAccess.ValueBool())
 		assert.True(t, model.AppAccess.ValueBool())
 	})
+
+	t.Run("keeps equivalent prior ip_access", func(t *testing.T) {
+		prior := PolicyResourceModel{IPAccess: stringSetOrNull([]string{" 10.0.0.1/8", "192.168.1.1"})}
+		resp := &policyAPIResponse{
+			ID:       "uuid-4",
+			Name:     "IP Policy",
+			IPAccess: []string{"192.168.1.1", "10.0.0.0/8"},
+		}
+
+		model := resp.toModel(prior)
+		assert.Equal(t, prior.IPAccess, model.IPAccess)
+
+		resp.IPAccess = []string{"10.0.0.0/8"}
+		model = resp.toModel(prior)
+		assert.Equal(t, []string{"10.0.0.0/8"}, stringSetValues(model.IPAccess))
+	})
 }
 
 // ---------------------------------------------------------------------------
@@ -402,7 +420,7 @@ func TestPolicyBuildRequestBody(t *testing.T) {
 			Name:        types.StringValue("Admin Policy"),
 			Icon:        types.StringValue("shield"),
 			Description: types.StringValue("Admin access"),
-			IPAccess:    types.StringValue("10.0.0.0/8"),
+			IPAccess:    stringSetOrNull([]string{"10.0.0.0/8"}),
 			EnforceTFA:  types.BoolValue(true),
 			AdminAccess: types.BoolValue(true),
 			AppAccess:   types.BoolValue(true),
@@ -444,3 +462,246 @@ func TestPolicyBuildRequestBody(t *testing.T) {
 		assert.NotContains(t, reqBody, "enforce_tfa")
 	})
 }
+
+// ---------------------------------------------------------------------------
+// ip_access validation and state upgrade
+// ---------------------------------------------------------------------------
+
+func TestNormalizeIPAccessEntry(t *testing.T) {
+	tests := []struct {
+		entry     string
+		expected  string
+		expectErr bool
+	}{
+		{"10.0.0.1", "10.0.0.1", false},
+		{" 10.0.0.1 ", "10.0.0.1", false},
+		{"2001:DB8:0:0::1", "2001:db8::1", false},
+		{"10.0.0.0/8", "10.0.0.0/8", false},
+		{"10.1.2.3/8", "10.0.0.0/8", false},
+		{"2001:db8::/32", "2001:db8::/32", false},
+		{"10.0.0.1-10.0.0.9", "10.0.0.1-10.0.0.9", false},
+		{"10.0.0.1 - 10.0.0.9", "10.0.0.1-10.0.0.9", false},
+		{"10.0.0.9-10.0.0.1", "", true},
+		{"10.0.0.1-::1", "", true},
+		{"10.0.0.0/33", "", true},
+		{"10.0.0.256", "", true},
+		{"localhost", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.entry, func(t *testing.T) {
+			result, err := normalizeIPAccessEntry(tt.entry)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNormalizeIPAccess(t *testing.T) {
+	assert.Equal(t,
+		[]string{"10.0.0.0/8", "192.168.0.1"},
+		normalizeIPAccess([]string{"192.168.0.1", "10.1.0.0/8", " 192.168.0.1", "10.0.0.0/8"}),
+	)
+	assert.Empty(t, normalizeIPAccess(nil))
+}
+
+func TestPolicyResource_ValidateConfig(t *testing.T) {
+	r := &PolicyResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		ipAccess  []string
+		expectErr bool
+	}{
+		{"unset", nil, false},
+		{"valid entries", []string{"10.0.0.0/8", "192.168.1.10", "172.16.0.1-172.16.0.20"}, false},
+		{"invalid CIDR", []string{"10.0.0.0/8", "10.0.0.0/40"}, true},
+		{"hostname", []string{"example.com"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := makeConfig(t, schema, &PolicyResourceModel{
+				ID:          types.StringNull(),
+				Name:        types.StringValue("Policy"),
+				IPAccess:    stringSetOrNull(tt.ipAccess),
+				EnforceTFA:  types.BoolNull(),
+				AdminAccess: types.BoolNull(),
+				AppAccess:   types.BoolNull(),
+				RoleIDs:     types.SetNull(types.StringType),
+				UserIDs:     types.SetNull(types.StringType),
+			})
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: config}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestPolicyResource_UpgradeState(t *testing.T) {
+	r := &PolicyResource{}
+	schema := getResourceSchema(t, r)
+	upgrader := r.UpgradeState(context.Background())[0]
+	require.NotNil(t, upgrader.PriorSchema)
+
+	tests := []struct {
+		name     string
+		csv      types.String
+		expected []string
+	}{
+		{"null", types.StringNull(), nil},
+		{"single entry", types.StringValue("10.0.0.0/8"), []string{"10.0.0.0/8"}},
+		{"entries with spaces", types.StringValue("10.0.0.0/8, 192.168.1.0/24,"), []string{"10.0.0.0/8", "192.168.1.0/24"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prior := makeState(t, *upgrader.PriorSchema, &policyResourceModelV0{
+				ID:          types.StringValue("uuid-1"),
+				Name:        types.StringValue("Legacy"),
+				Icon:        types.StringValue("shield"),
+				Description: types.StringNull(),
+				IPAccess:    tt.csv,
+				EnforceTFA:  types.BoolValue(true),
+				AdminAccess: types.BoolValue(false),
+				AppAccess:   types.BoolValue(true),
+			})
+
+			resp := &fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: schema}}
+			upgrader.StateUpgrader(context.Background(), fwresource.UpgradeStateRequest{State: &prior}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var upgraded PolicyResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &upgraded)...)
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			assert.Equal(t, "uuid-1", upgraded.ID.ValueString())
+			assert.Equal(t, "shield", upgraded.Icon.ValueString())
+			assert.True(t, upgraded.EnforceTFA.ValueBool())
+			assert.ElementsMatch(t, tt.expected, stringSetValues(upgraded.IPAccess))
+			assert.Equal(t, tt.expected == nil, upgraded.IPAccess.IsNull())
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Computed relationships
+// ---------------------------------------------------------------------------
+
+func TestPolicyResource_Read_Relationships(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/policies/uuid-1", req.URL.Path)
+		assert.Equal(t, policyReadFields, req.URL.Query().Get("fields"))
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":   "uuid-1",
+				"name": "Shared Policy",
+				"roles": []interface{}{
+					map[string]interface{}{"role": "role-b"},
+					map[string]interface{}{"role": "role-a"},
+					map[string]interface{}{"role": nil},
+				},
+				"users": []interface{}{
+					map[string]interface{}{"user": "user-1"},
+					map[string]interface{}{"user": nil},
+				},
+				"permissions": []interface{}{
+					map[string]interface{}{"id": 1},
+					map[string]interface{}{"id": 2},
+					map[string]interface{}{"id": 3},
+				},
+			},
+		}), nil
+	})
+
+	r := &PolicyResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &PolicyResourceModel{
+		ID:       types.StringValue("uuid-1"),
+		Name:     types.StringValue("Shared Policy"),
+		IPAccess: types.SetNull(types.StringType),
+		RoleIDs:  types.SetNull(types.StringType),
+		UserIDs:  types.SetNull(types.StringType),
+	})
+
+	resp := &fwresource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result PolicyResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, []string{"role-a", "role-b"}, stringSetValues(result.RoleIDs))
+	assert.Equal(t, []string{"user-1"}, stringSetValues(result.UserIDs))
+	assert.Equal(t, int64(3), result.PermissionCount.ValueInt64())
+}
+
+func TestPolicyAPIResponseToModel_UnexpandedRelations(t *testing.T) {
+	var resp struct {
+		Data policyAPIResponse `json:"data"`
+	}
+	body := `{"data":{"id":"uuid-1","name":"Policy","roles":["access-1"],"users":[],"permissions":[7,8]}}`
+	require.NoError(t, json.Unmarshal([]byte(body), &resp))
+
+	prior := PolicyResourceModel{
+		RoleIDs: stringSetOrNull([]string{"role-a"}),
+		UserIDs: stringSetOrNull([]string{"user-1"}),
+	}
+	model := resp.Data.toModel(prior)
+
+	assert.Equal(t, prior.RoleIDs, model.RoleIDs, "unexpanded roles keep the prior value")
+	assert.True(t, model.UserIDs.IsNull(), "an empty relation is known to be empty")
+	assert.Equal(t, int64(2), model.PermissionCount.ValueInt64())
+}
+
+func TestPolicyResource_ModifyPlan_Destroy(t *testing.T) {
+	tests := []struct {
+		name        string
+		roleIDs     []string
+		userIDs     []string
+		wantWarning bool
+	}{
+		{"unattached", nil, nil, false},
+		{"attached to roles", []string{"role-a", "role-b"}, nil, true},
+		{"attached to users", nil, []string{"user-1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PolicyResource{}
+			schema := getResourceSchema(t, r)
+
+			state := makeState(t, schema, &PolicyResourceModel{
+				ID:              types.StringValue("uuid-1"),
+				Name:            types.StringValue("Shared Policy"),
+				IPAccess:        types.SetNull(types.StringType),
+				RoleIDs:         stringSetOrNull(tt.roleIDs),
+				UserIDs:         stringSetOrNull(tt.userIDs),
+				PermissionCount: types.Int64Value(0),
+			})
+			plan := tfsdk.Plan{Schema: schema, Raw: makeNullState(t, schema).Raw}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if !tt.wantWarning {
+				assert.Empty(t, resp.Diagnostics.Warnings())
+				return
+			}
+			require.Len(t, resp.Diagnostics.Warnings(), 1)
+			assert.Equal(t, "Destroying Attached Policy", resp.Diagnostics.Warnings()[0].Summary())
+			assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(),
+				fmt.Sprintf("%d role(s) and %d user(s)", len(tt.roleIDs), len(tt.userIDs)))
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &PresetResource{}
+	_ resource.ResourceWithConfigure      = &PresetResource{}
+	_ resource.ResourceWithImportState    = &PresetResource{}
+	_ resource.ResourceWithValidateConfig = &PresetResource{}
+)
+
+// NewPresetResource creates a new preset resource.
+func NewPresetResource() resource.Resource {
+	return &PresetResource{}
+}
+
+// PresetResource defines the resource implementation.
+type PresetResource struct {
+	client *client.Client
+}
+
+// PresetResourceModel describes the resource data model.
+type PresetResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Collection    types.String `tfsdk:"collection"`
+	Role          types.String `tfsdk:"role"`
+	User          types.String `tfsdk:"user"`
+	Bookmark      types.String `tfsdk:"bookmark"`
+	Layout        types.String `tfsdk:"layout"`
+	LayoutQuery   types.String `tfsdk:"layout_query"`
+	LayoutOptions types.String `tfsdk:"layout_options"`
+	Filter        types.String `tfsdk:"filter"`
+	Search        types.String `tfsdk:"search"`
+	Icon          types.String `tfsdk:"icon"`
+	Color         types.String `tfsdk:"color"`
+}
+
+func (r *PresetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preset"
+}
+
+func (r *PresetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Preset resource. Presets store the layout, query and filters of a collection's item list. " +
+			"A preset with a `bookmark` name is shown as a bookmark; without one it is the default layout of the collection " +
+			"for the given role or user (or for everyone when neither is set).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the preset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				MarkdownDescription: "The collection the preset applies to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the role the preset applies to. Conflicts with `user`.",
+				Optional:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the user the preset applies to. Conflicts with `role`.",
+				Optional:            true,
+			},
+			"bookmark": schema.StringAttribute{
+				MarkdownDescription: "Name of the bookmark. Leave empty to define the default layout of the collection.",
+				Optional:            true,
+			},
+			"layout": schema.StringAttribute{
+				MarkdownDescription: "Key of the layout, e.g. `tabular`, `cards`, `calendar`, `kanban` or `map`.",
+				Optional:            true,
+			},
+			"layout_query": schema.StringAttribute{
+				MarkdownDescription: "JSON object of the layout query (fields, sort, limit) keyed by layout.",
+				Optional:            true,
+			},
+			"layout_options": schema.StringAttribute{
+				MarkdownDescription: "JSON object of layout options (e.g. column widths) keyed by layout.",
+				Optional:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "JSON object of the filter applied to the item list.",
+				Optional:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Search query applied to the item list.",
+				Optional:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "The name of a Google Material Design Icon for the bookmark.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "A color hex code for the bookmark icon (e.g., #6644FF).",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that role and user are mutually exclusive and that the JSON attributes are valid.
+func (r *PresetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PresetResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Role.IsNull() && !data.User.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user"),
+			"Conflicting Preset Scope",
+			"Only one of role or user can be set. Leave both empty to create a preset for everyone.",
+		)
+	}
+
+	for _, attr := range []struct {
+		name  string
+		value types.String
+	}{
+		{"layout_query", data.LayoutQuery},
+		{"layout_options", data.LayoutOptions},
+		{"filter", data.Filter},
+	} {
+		if err := validateJSONString(attr.value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.name), "Invalid Preset JSON", err.Error())
+		}
+	}
+}
+
+func (r *PresetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *PresetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PresetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data presetAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "presets", buildPresetInput(plan, true), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Preset",
+			"Could not create preset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+func (r *PresetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PresetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data presetAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "presets", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Preset",
+			"Could not read preset ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(state))...)
+}
+
+func (r *PresetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PresetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data presetAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "presets", plan.ID.ValueString(), buildPresetInput(plan, false), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Preset",
+			"Could not update preset ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan))...)
+}
+
+func (r *PresetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PresetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "presets", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Preset",
+			"Could not delete preset ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *PresetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// presetAPIResponse represents the API response for preset operations.
+type presetAPIResponse struct {
+	ID            flexibleInt64   `json:"id"`
+	Collection    string          `json:"collection"`
+	Role          string          `json:"role,omitempty"`
+	User          string          `json:"user,omitempty"`
+	Bookmark      string          `json:"bookmark,omitempty"`
+	Layout        string          `json:"layout,omitempty"`
+	LayoutQuery   json.RawMessage `json:"layout_query,omitempty"`
+	LayoutOptions json.RawMessage `json:"layout_options,omitempty"`
+	Filter        json.RawMessage `json:"filter,omitempty"`
+	Search        string          `json:"search,omitempty"`
+	Icon          string          `json:"icon,omitempty"`
+	Color         string          `json:"color,omitempty"`
+}
+
+// toModel converts presetAPIResponse to PresetResourceModel, keeping the prior
+// JSON strings when they are semantically equal to the API values.
+func (p *presetAPIResponse) toModel(prior PresetResourceModel) *PresetResourceModel {
+	return &PresetResourceModel{
+		ID:            types.StringValue(strconv.FormatInt(int64(p.ID), 10)),
+		Collection:    types.StringValue(p.Collection),
+		Role:          stringOrNull(p.Role),
+		User:          stringOrNull(p.User),
+		Bookmark:      stringOrNull(p.Bookmark),
+		Layout:        stringOrNull(p.Layout),
+		LayoutQuery:   jsonObjectValue(prior.LayoutQuery, p.LayoutQuery),
+		LayoutOptions: jsonObjectValue(prior.LayoutOptions, p.LayoutOptions),
+		Filter:        jsonObjectValue(prior.Filter, p.Filter),
+		Search:        stringOrNull(p.Search),
+		Icon:          stringOrNull(p.Icon),
+		Color:         stringOrNull(p.Color),
+	}
+}
+
+// buildPresetInput constructs the input from the resource model (used for both create and update)
+func buildPresetInput(data PresetResourceModel, isCreate bool) map[string]interface{} {
+	input := map[string]interface{}{}
+
+	setStringField(input, "icon", data.Icon)
+	setJSONField(input, "layout_query", data.LayoutQuery)
+	setJSONField(input, "layout_options", data.LayoutOptions)
+	setJSONField(input, "filter", data.Filter)
+
+	if isCreate {
+		input["collection"] = data.Collection.ValueString()
+		setStringField(input, "role", data.Role)
+		setStringField(input, "user", data.User)
+		setStringField(input, "bookmark", data.Bookmark)
+		setStringField(input, "layout", data.Layout)
+		setStringField(input, "search", data.Search)
+		setStringField(input, "color", data.Color)
+	} else {
+		setNullableStringField(input, "role", data.Role)
+		setNullableStringField(input, "user", data.User)
+		setNullableStringField(input, "bookmark", data.Bookmark)
+		setNullableStringField(input, "layout", data.Layout)
+		setNullableStringField(input, "search", data.Search)
+		setNullableStringField(input, "color", data.Color)
+		for key, value := range map[string]types.String{
+			"layout_query":   data.LayoutQuery,
+			"layout_options": data.LayoutOptions,
+			"filter":         data.Filter,
+		} {
+			if value.IsNull() {
+				input[key] = nil
+			}
+		}
+	}
+
+	return input
+}
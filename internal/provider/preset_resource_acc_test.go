@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPreset_roleBookmark(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_preset", "presets"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_role" "test" {
+  name = "AccTest Preset Role"
+}
+
+resource "directus_preset" "test" {
+  collection = "directus_users"
+  role       = directus_role.test.id
+  bookmark   = "AccTest Active Users"
+  layout     = "tabular"
+
+  layout_query = jsonencode({
+    tabular = {
+      fields = ["first_name", "last_name", "email"]
+      sort   = ["email"]
+    }
+  })
+
+  filter = jsonencode({
+    status = { _eq = "active" }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_preset.test", "id"),
+					resource.TestCheckResourceAttrPair("directus_preset.test", "role", "directus_role.test", "id"),
+					resource.TestCheckResourceAttr("directus_preset.test", "bookmark", "AccTest Active Users"),
+				),
+			},
+			// Drop the filter and switch the layout
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_role" "test" {
+  name = "AccTest Preset Role"
+}
+
+resource "directus_preset" "test" {
+  collection = "directus_users"
+  role       = directus_role.test.id
+  bookmark   = "AccTest Active Users"
+  layout     = "cards"
+  color      = "#2ECDA7"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_preset.test", "layout", "cards"),
+					resource.TestCheckNoResourceAttr("directus_preset.test", "filter"),
+					resource.TestCheckNoResourceAttr("directus_preset.test", "layout_query"),
+				),
+			},
+			{
+				ResourceName:      "directus_preset.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
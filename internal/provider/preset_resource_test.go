@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestPresetResourceSchema(t *testing.T) {
+	r := &PresetResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "collection", "role", "user", "bookmark", "layout", "layout_query", "layout_options", "filter", "search", "icon", "color"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["collection"].IsRequired())
+}
+
+func TestPresetResourceMetadata(t *testing.T) {
+	r := &PresetResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_preset", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newPresetModel() *PresetResourceModel {
+	return &PresetResourceModel{
+		ID:            types.StringNull(),
+		Collection:    types.StringValue("articles"),
+		Role:          types.StringNull(),
+		User:          types.StringNull(),
+		Bookmark:      types.StringNull(),
+		Layout:        types.StringNull(),
+		LayoutQuery:   types.StringNull(),
+		LayoutOptions: types.StringNull(),
+		Filter:        types.StringNull(),
+		Search:        types.StringNull(),
+		Icon:          types.StringNull(),
+		Color:         types.StringNull(),
+	}
+}
+
+func TestPresetResource_ValidateConfig(t *testing.T) {
+	r := &PresetResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *PresetResourceModel)
+		expectErr bool
+	}{
+		{"global", func(m *PresetResourceModel) {}, false},
+		{"role scoped", func(m *PresetResourceModel) { m.Role = types.StringValue("role-1") }, false},
+		{"user scoped", func(m *PresetResourceModel) { m.User = types.StringValue("user-1") }, false},
+		{"role and user", func(m *PresetResourceModel) {
+			m.Role = types.StringValue("role-1")
+			m.User = types.StringValue("user-1")
+		}, true},
+		{"valid json", func(m *PresetResourceModel) {
+			m.LayoutQuery = types.StringValue(`{"tabular":{"fields":["title"]}}`)
+			m.Filter = types.StringValue(`{"status":{"_eq":"draft"}}`)
+		}, false},
+		{"invalid layout_query", func(m *PresetResourceModel) { m.LayoutQuery = types.StringValue("{") }, true},
+		{"invalid layout_options", func(m *PresetResourceModel) { m.LayoutOptions = types.StringValue("nope") }, true},
+		{"invalid filter", func(m *PresetResourceModel) { m.Filter = types.StringValue("{status}") }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newPresetModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildPresetInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildPresetInput(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		model := newPresetModel()
+		model.Role = types.StringValue("role-1")
+		model.Layout = types.StringValue("tabular")
+		model.LayoutQuery = types.StringValue(`{"tabular":{"sort":["-date_created"]}}`)
+
+		input := buildPresetInput(*model, true)
+
+		assert.Equal(t, "articles", input["collection"])
+		assert.Equal(t, "role-1", input["role"])
+		assert.Equal(t, "tabular", input["layout"])
+		assert.Equal(t, map[string]interface{}{
+			"tabular": map[string]interface{}{"sort": []interface{}{"-date_created"}},
+		}, input["layout_query"])
+		assert.NotContains(t, input, "user")
+		assert.NotContains(t, input, "filter")
+	})
+
+	t.Run("update clears removed fields", func(t *testing.T) {
+		input := buildPresetInput(*newPresetModel(), false)
+
+		assert.NotContains(t, input, "collection")
+		for _, key := range []string{"role", "user", "bookmark", "layout", "search", "color", "layout_query", "layout_options", "filter"} {
+			assert.Contains(t, input, key)
+			assert.Nil(t, input[key], key)
+		}
+	})
+}
+
+func TestPresetAPIResponseToModel(t *testing.T) {
+	prior := newPresetModel()
+	prior.Filter = types.StringValue(`{ "status": { "_eq": "draft" } }`)
+
+	model := (&presetAPIResponse{
+		ID: 7, Collection: "articles", Role: "role-1", Bookmark: "Drafts", Icon: "bookmark",
+		Filter: json.RawMessage(`{"status":{"_eq":"draft"}}`),
+	}).toModel(*prior)
+
+	assert.Equal(t, "7", model.ID.ValueString())
+	assert.Equal(t, "role-1", model.Role.ValueString())
+	assert.True(t, model.User.IsNull())
+	assert.Equal(t, "Drafts", model.Bookmark.ValueString())
+	assert.Equal(t, prior.Filter.ValueString(), model.Filter.ValueString(), "semantically equal filter should keep the prior value")
+	assert.True(t, model.LayoutQuery.IsNull())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestPresetResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/presets", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "articles", body["collection"])
+		assert.Equal(t, "Drafts", body["bookmark"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": 7, "collection": "articles", "role": "role-1", "user": nil, "bookmark": "Drafts",
+				"layout": "tabular", "layout_query": nil, "layout_options": nil,
+				"filter": map[string]interface{}{"status": map[string]interface{}{"_eq": "draft"}},
+				"search": nil, "icon": "bookmark", "color": nil,
+			},
+		}), nil
+	})
+
+	r := &PresetResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newPresetModel()
+	model.ID = types.StringUnknown()
+	model.Role = types.StringValue("role-1")
+	model.Bookmark = types.StringValue("Drafts")
+	model.Layout = types.StringValue("tabular")
+	model.Filter = types.StringValue(`{"status":{"_eq":"draft"}}`)
+	model.Icon = types.StringUnknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result PresetResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "7", result.ID.ValueString())
+	assert.Equal(t, "bookmark", result.Icon.ValueString())
+	assert.Equal(t, `{"status":{"_eq":"draft"}}`, result.Filter.ValueString())
+}
+
+func TestPresetResource_Update(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/presets/7", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "cards", body["layout"])
+		assert.Contains(t, body, "filter")
+		assert.Nil(t, body["filter"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": 7, "collection": "articles", "layout": "cards", "icon": "bookmark"},
+		}), nil
+	})
+
+	r := &PresetResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newPresetModel()
+	model.ID = types.StringValue("7")
+	model.Layout = types.StringValue("cards")
+	model.Icon = types.StringValue("bookmark")
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: makePlan(t, schema, model)}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+}
+
+func TestPresetResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/presets/7", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &PresetResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newPresetModel()
+	model.ID = types.StringValue("7")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,10 +13,16 @@ import (
 	"github.com/kylindc/terraform-provider-directus/internal/client"
 )
 
-var _ provider.Provider = &DirectusProvider{}
+var (
+	_ provider.Provider                       = &DirectusProvider{}
+	_ provider.ProviderWithEphemeralResources = &DirectusProvider{}
+)
 
 type DirectusProvider struct {
 	version string
+
+	// roleParents records the role parents planned since the provider was last configured.
+	roleParents *roleParentRegistry
 }
 
 type DirectusProviderModel struct {
@@ -78,22 +85,61 @@ func (p *DirectusProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// Planned role parents are only valid for the plan or apply this configuration belongs to.
+	p.roleParents = newRoleParentRegistry()
+
 	// Make the client available to resources
 	resp.DataSourceData = directusClient
 	resp.ResourceData = directusClient
+	resp.EphemeralResourceData = directusClient
 }
 
 func (p *DirectusProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewPolicyResource,
-		NewRoleResource,
+		p.newRoleResource,
 		NewRolePoliciesAttachmentResource,
 		NewCollectionResource,
+		NewAccessResource,
+		NewFolderResource,
+		NewFileResource,
+		NewFlowResource,
+		NewOperationResource,
+		NewDashboardResource,
+		NewPanelResource,
+		NewSettingsResource,
+		NewPresetResource,
+		NewTranslationResource,
+		NewTranslationsResource,
+		NewItemResource,
+		NewItemsResource,
+		NewShareResource,
+		NewExtensionResource,
+	}
+}
+
+// newRoleResource creates a role resource that records planned parents in the registry of
+// the current provider configuration.
+func (p *DirectusProvider) newRoleResource() resource.Resource {
+	return &RoleResource{parents: p.roleParents}
+}
+
+func (p *DirectusProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewUserTokenEphemeralResource,
 	}
 }
 
 func (p *DirectusProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// TODO: Add data sources if needed
+		NewRoleDataSource,
+		NewPolicyDataSource,
+		NewRolesDataSource,
+		NewPoliciesDataSource,
+		NewCollectionsDataSource,
+		NewFieldsDataSource,
+		NewServerInfoDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
 	}
 }
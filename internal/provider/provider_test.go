@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/stretchr/testify/assert"
@@ -76,8 +77,8 @@ func TestDirectusProvider_Resources(t *testing.T) {
 
 	resources := p.Resources(context.Background())
 
-	// Should return 4 resource factories
-	assert.Len(t, resources, 4, "Should have 4 resources")
+	// Should return 19 resource factories
+	assert.Len(t, resources, 19, "Should have 19 resources")
 
 	// Instantiate each and verify type names
 	expectedTypeNames := map[string]bool{
@@ -85,6 +86,21 @@ func TestDirectusProvider_Resources(t *testing.T) {
 		"directus_role":                      false,
 		"directus_role_policies_attachment":  false,
 		"directus_collection":               false,
+		"directus_access":                   false,
+		"directus_folder":                   false,
+		"directus_file":                     false,
+		"directus_flow":                     false,
+		"directus_operation":                false,
+		"directus_dashboard":                false,
+		"directus_panel":                    false,
+		"directus_settings":                 false,
+		"directus_preset":                   false,
+		"directus_translation":              false,
+		"directus_translations":             false,
+		"directus_item":                     false,
+		"directus_items":                    false,
+		"directus_share":                    false,
+		"directus_extension":                false,
 	}
 
 	for _, factory := range resources {
@@ -105,6 +121,26 @@ func TestDirectusProvider_Resources(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// EphemeralResources
+// ---------------------------------------------------------------------------
+
+func TestDirectusProvider_EphemeralResources(t *testing.T) {
+	p := &DirectusProvider{}
+
+	ephemeralResources := p.EphemeralResources(context.Background())
+	assert.Len(t, ephemeralResources, 1, "Should have 1 ephemeral resource")
+
+	names := make([]string, 0, len(ephemeralResources))
+	for _, factory := range ephemeralResources {
+		r := factory()
+		resp := &ephemeral.MetadataResponse{}
+		r.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "directus"}, resp)
+		names = append(names, resp.TypeName)
+	}
+	assert.Contains(t, names, "directus_user_token")
+}
+
 // ---------------------------------------------------------------------------
 // DataSources
 // ---------------------------------------------------------------------------
@@ -113,7 +149,35 @@ func TestDirectusProvider_DataSources(t *testing.T) {
 	p := &DirectusProvider{}
 
 	dataSources := p.DataSources(context.Background())
-	assert.Empty(t, dataSources, "Should have no data sources yet")
+
+	expectedTypeNames := map[string]bool{
+		"directus_role":        false,
+		"directus_policy":      false,
+		"directus_roles":       false,
+		"directus_policies":    false,
+		"directus_collections": false,
+		"directus_fields":      false,
+		"directus_server_info": false,
+		"directus_user":        false,
+		"directus_users":       false,
+	}
+	assert.Len(t, dataSources, len(expectedTypeNames))
+
+	for _, factory := range dataSources {
+		d := factory()
+		require.NotNil(t, d)
+
+		metaResp := &datasource.MetadataResponse{}
+		d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "directus"}, metaResp)
+
+		_, exists := expectedTypeNames[metaResp.TypeName]
+		assert.True(t, exists, "unexpected data source type: %s", metaResp.TypeName)
+		expectedTypeNames[metaResp.TypeName] = true
+	}
+
+	for name, found := range expectedTypeNames {
+		assert.True(t, found, "data source %s not found", name)
+	}
 }
 
 // ---------------------------------------------------------------------------
@@ -122,6 +186,7 @@ func TestDirectusProvider_DataSources(t *testing.T) {
 
 func TestDirectusProvider_ImplementsProviderInterface(t *testing.T) {
 	var _ fwprovider.Provider = &DirectusProvider{}
+	var _ fwprovider.ProviderWithEphemeralResources = &DirectusProvider{}
 }
 
 // ---------------------------------------------------------------------------
@@ -174,6 +239,102 @@ func TestNewCollectionResource_ReturnsCorrectType(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestNewAccessResource_ReturnsCorrectType(t *testing.T) {
+	r := NewAccessResource()
+	_, ok := r.(*AccessResource)
+	assert.True(t, ok)
+}
+
+func TestNewFolderResource_ReturnsCorrectType(t *testing.T) {
+	r := NewFolderResource()
+	_, ok := r.(*FolderResource)
+	assert.True(t, ok)
+}
+
+func TestNewFileResource_ReturnsCorrectType(t *testing.T) {
+	r := NewFileResource()
+	_, ok := r.(*FileResource)
+	assert.True(t, ok)
+}
+
+func TestNewFlowResource_ReturnsCorrectType(t *testing.T) {
+	r := NewFlowResource()
+	_, ok := r.(*FlowResource)
+	assert.True(t, ok)
+}
+
+func TestNewOperationResource_ReturnsCorrectType(t *testing.T) {
+	r := NewOperationResource()
+	_, ok := r.(*OperationResource)
+	assert.True(t, ok)
+}
+
+func TestNewDashboardResource_ReturnsCorrectType(t *testing.T) {
+	r := NewDashboardResource()
+	_, ok := r.(*DashboardResource)
+	assert.True(t, ok)
+}
+
+func TestNewPanelResource_ReturnsCorrectType(t *testing.T) {
+	r := NewPanelResource()
+	_, ok := r.(*PanelResource)
+	assert.True(t, ok)
+}
+
+func TestNewSettingsResource_ReturnsCorrectType(t *testing.T) {
+	r := NewSettingsResource()
+	_, ok := r.(*SettingsResource)
+	assert.True(t, ok)
+}
+
+func TestNewPresetResource_ReturnsCorrectType(t *testing.T) {
+	r := NewPresetResource()
+	_, ok := r.(*PresetResource)
+	assert.True(t, ok)
+}
+
+func TestNewTranslationResource_ReturnsCorrectType(t *testing.T) {
+	r := NewTranslationResource()
+	_, ok := r.(*TranslationResource)
+	assert.True(t, ok)
+}
+
+func TestNewTranslationsResource_ReturnsCorrectType(t *testing.T) {
+	r := NewTranslationsResource()
+	_, ok := r.(*TranslationsResource)
+	assert.True(t, ok)
+}
+
+func TestNewUserTokenEphemeralResource_ReturnsCorrectType(t *testing.T) {
+	r := NewUserTokenEphemeralResource()
+	_, ok := r.(*UserTokenEphemeralResource)
+	assert.True(t, ok)
+}
+
+func TestNewItemResource_ReturnsCorrectType(t *testing.T) {
+	r := NewItemResource()
+	_, ok := r.(*ItemResource)
+	assert.True(t, ok)
+}
+
+func TestNewItemsResource_ReturnsCorrectType(t *testing.T) {
+	r := NewItemsResource()
+	_, ok := r.(*ItemsResource)
+	assert.True(t, ok)
+}
+
+func TestNewShareResource_ReturnsCorrectType(t *testing.T) {
+	r := NewShareResource()
+	_, ok := r.(*ShareResource)
+	assert.True(t, ok)
+}
+
+func TestNewExtensionResource_ReturnsCorrectType(t *testing.T) {
+	r := NewExtensionResource()
+	_, ok := r.(*ExtensionResource)
+	assert.True(t, ok)
+}
+
 // ---------------------------------------------------------------------------
 // Configure with wrong ProviderData type
 // ---------------------------------------------------------------------------
@@ -8,10 +8,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,12 +50,62 @@ func makeState(t *testing.T, schema rschema.Schema, model interface{}) tfsdk.Sta
 	return state
 }
 
+// makeConfig creates a tfsdk.Config populated with the given model.
+func makeConfig(t *testing.T, schema rschema.Schema, model interface{}) tfsdk.Config {
+	t.Helper()
+	state := makeState(t, schema, model)
+	return tfsdk.Config{Schema: schema, Raw: state.Raw}
+}
+
+// makeNullState creates a State whose root object is null, as Terraform sends on import.
+func makeNullState(t *testing.T, schema rschema.Schema) tfsdk.State {
+	t.Helper()
+	return tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+// getDataSourceSchema extracts the schema from a data source.
+func getDataSourceSchema(t *testing.T, d fwdatasource.DataSource) dschema.Schema {
+	t.Helper()
+	resp := &fwdatasource.SchemaResponse{}
+	d.Schema(context.Background(), fwdatasource.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+	return resp.Schema
+}
+
+// makeDataSourceConfig creates a tfsdk.Config for a data source populated with the given model.
+func makeDataSourceConfig(t *testing.T, schema dschema.Schema, model interface{}) tfsdk.Config {
+	t.Helper()
+	state := tfsdk.State{Schema: schema}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), "makeDataSourceConfig: %v", diags)
+	return tfsdk.Config{Schema: schema, Raw: state.Raw}
+}
+
 // ===========================================================================
 // Policy Resource CRUD tests
 // ===========================================================================
 
 func TestPolicyResource_Create_Full(t *testing.T) {
+	var requests []string
 	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "GET" {
+			assert.Equal(t, policyReadFields, req.URL.Query().Get("fields"))
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":          "uuid-1",
+					"name":        "Test Policy",
+					"app_access":  true,
+					"roles":       []interface{}{},
+					"users":       []interface{}{},
+					"permissions": []interface{}{},
+				},
+			}), nil
+		}
+
 		assert.Equal(t, "POST", req.Method)
 		assert.Equal(t, "http://example.com/policies", req.URL.String())
 
@@ -80,22 +133,26 @@ func TestPolicyResource_Create_Full(t *testing.T) {
 		Name:        types.StringValue("Test Policy"),
 		Icon:        types.StringNull(),
 		Description: types.StringNull(),
-		IPAccess:    types.StringNull(),
+		IPAccess:    types.SetNull(types.StringType),
 		EnforceTFA:  types.BoolValue(false),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(true),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
 	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
 
 	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []string{"POST /policies", "GET /policies/uuid-1"}, requests)
 
 	var result PolicyResourceModel
 	resp.State.Get(context.Background(), &result)
 	assert.Equal(t, "uuid-1", result.ID.ValueString())
 	assert.Equal(t, "Test Policy", result.Name.ValueString())
 	assert.True(t, result.AppAccess.ValueBool())
+	assert.True(t, result.RoleIDs.IsNull())
 }
 
 func TestPolicyResource_Create_Error(t *testing.T) {
@@ -112,6 +169,9 @@ func TestPolicyResource_Create_Error(t *testing.T) {
 		EnforceTFA:  types.BoolValue(false),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(false),
+		IPAccess:    types.SetNull(types.StringType),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
@@ -142,6 +202,9 @@ func TestPolicyResource_Read_Full(t *testing.T) {
 		EnforceTFA:  types.BoolValue(false),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(false),
+		IPAccess:    types.SetNull(types.StringType),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.ReadResponse{State: tfsdk.State{Schema: schema}}
@@ -170,6 +233,9 @@ func TestPolicyResource_Read_Error(t *testing.T) {
 		EnforceTFA:  types.BoolValue(false),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(false),
+		IPAccess:    types.SetNull(types.StringType),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.ReadResponse{State: tfsdk.State{Schema: schema}}
@@ -180,6 +246,16 @@ func TestPolicyResource_Read_Error(t *testing.T) {
 
 func TestPolicyResource_Update_Full(t *testing.T) {
 	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method == "GET" {
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":          "uuid-1",
+					"name":        "Updated",
+					"enforce_tfa": true,
+				},
+			}), nil
+		}
+
 		assert.Equal(t, "PATCH", req.Method)
 
 		var body map[string]interface{}
@@ -205,6 +281,9 @@ func TestPolicyResource_Update_Full(t *testing.T) {
 		EnforceTFA:  types.BoolValue(true),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(false),
+		IPAccess:    types.SetNull(types.StringType),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
@@ -218,6 +297,58 @@ func TestPolicyResource_Update_Full(t *testing.T) {
 	assert.True(t, result.EnforceTFA.ValueBool())
 }
 
+func TestPolicyResource_Update_ReadsRelations(t *testing.T) {
+	// The update response lists the relations without expanding them; the state must
+	// come from the policy read back with policyReadFields.
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method == "PATCH" {
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":    "uuid-1",
+					"name":  "Updated",
+					"roles": []interface{}{},
+					"users": []interface{}{},
+				},
+			}), nil
+		}
+
+		assert.Equal(t, policyReadFields, req.URL.Query().Get("fields"))
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":    "uuid-1",
+				"name":  "Updated",
+				"roles": []interface{}{map[string]interface{}{"role": "role-a"}},
+				"users": []interface{}{},
+			},
+		}), nil
+	})
+
+	r := &PolicyResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &PolicyResourceModel{
+		ID:              types.StringValue("uuid-1"),
+		Name:            types.StringValue("Updated"),
+		EnforceTFA:      types.BoolValue(false),
+		AdminAccess:     types.BoolValue(false),
+		AppAccess:       types.BoolValue(false),
+		IPAccess:        types.SetNull(types.StringType),
+		RoleIDs:         stringSetOrNull([]string{"role-a"}),
+		UserIDs:         types.SetNull(types.StringType),
+		PermissionCount: types.Int64Value(0),
+	})
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+
+	var result PolicyResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, []string{"role-a"}, stringSetValues(result.RoleIDs))
+	assert.True(t, result.UserIDs.IsNull())
+}
+
 func TestPolicyResource_Update_Error(t *testing.T) {
 	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
 		return mockErrorResponse(403, "Forbidden"), nil
@@ -232,6 +363,9 @@ func TestPolicyResource_Update_Error(t *testing.T) {
 		EnforceTFA:  types.BoolValue(false),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(false),
+		IPAccess:    types.SetNull(types.StringType),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
@@ -256,6 +390,9 @@ func TestPolicyResource_Delete_Full(t *testing.T) {
 		EnforceTFA:  types.BoolValue(false),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(false),
+		IPAccess:    types.SetNull(types.StringType),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.DeleteResponse{State: tfsdk.State{Schema: schema}}
@@ -278,6 +415,9 @@ func TestPolicyResource_Delete_Error(t *testing.T) {
 		EnforceTFA:  types.BoolValue(false),
 		AdminAccess: types.BoolValue(false),
 		AppAccess:   types.BoolValue(false),
+		IPAccess:    types.SetNull(types.StringType),
+		RoleIDs:     types.SetNull(types.StringType),
+		UserIDs:     types.SetNull(types.StringType),
 	})
 
 	resp := &fwresource.DeleteResponse{State: tfsdk.State{Schema: schema}}
@@ -510,10 +650,12 @@ func TestCollectionResource_Create_Full(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	plan := makePlan(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("articles"),
-		Icon:       types.StringValue("article"),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("articles"),
+		Icon:                  types.StringValue("article"),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
@@ -535,9 +677,11 @@ func TestCollectionResource_Create_Error(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	plan := makePlan(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("bad"),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("bad"),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
@@ -564,9 +708,11 @@ func TestCollectionResource_Read_Full(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	state := makeState(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("articles"),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("articles"),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.ReadResponse{State: tfsdk.State{Schema: schema}}
@@ -590,9 +736,11 @@ func TestCollectionResource_Read_Error(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	state := makeState(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("nonexistent"),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("nonexistent"),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.ReadResponse{State: tfsdk.State{Schema: schema}}
@@ -619,10 +767,12 @@ func TestCollectionResource_Update_Full(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	plan := makePlan(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("articles"),
-		Note:       types.StringValue("Updated note"),
-		Hidden:     types.BoolValue(true),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("articles"),
+		Note:                  types.StringValue("Updated note"),
+		Hidden:                types.BoolValue(true),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
@@ -645,9 +795,11 @@ func TestCollectionResource_Update_Error(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	plan := makePlan(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("articles"),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("articles"),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
@@ -658,6 +810,13 @@ func TestCollectionResource_Update_Error(t *testing.T) {
 
 func TestCollectionResource_Delete_Full(t *testing.T) {
 	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		// Empty collections can be deleted without force_destroy
+		if req.Method == "GET" {
+			assert.Equal(t, "/items/articles", req.URL.Path)
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []interface{}{map[string]interface{}{"count": 0}},
+			}), nil
+		}
 		assert.Equal(t, "DELETE", req.Method)
 		assert.Contains(t, req.URL.String(), "/collections/articles")
 		return mockJSONResponse(204, nil), nil
@@ -667,9 +826,11 @@ func TestCollectionResource_Delete_Full(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	state := makeState(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("articles"),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("articles"),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.DeleteResponse{State: tfsdk.State{Schema: schema}}
@@ -687,9 +848,11 @@ func TestCollectionResource_Delete_Error(t *testing.T) {
 	schema := getResourceSchema(t, r)
 
 	state := makeState(t, schema, &CollectionResourceModel{
-		Collection: types.StringValue("articles"),
-		Hidden:     types.BoolValue(false),
-		Singleton:  types.BoolValue(false),
+		Collection:            types.StringValue("articles"),
+		Hidden:                types.BoolValue(false),
+		Singleton:             types.BoolValue(false),
+		ItemDuplicationFields: types.ListNull(types.StringType),
+		PrimaryKey:            types.ObjectNull(collectionPrimaryKeyAttrTypes),
 	})
 
 	resp := &fwresource.DeleteResponse{State: tfsdk.State{Schema: schema}}
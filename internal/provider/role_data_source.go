@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &RoleDataSource{}
+	_ datasource.DataSourceWithConfigure      = &RoleDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &RoleDataSource{}
+)
+
+// NewRoleDataSource creates a new role data source.
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+// RoleDataSource defines the data source implementation.
+type RoleDataSource struct {
+	client *client.Client
+}
+
+// RoleDataSourceModel describes the data source data model.
+type RoleDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Parent      types.String `tfsdk:"parent"`
+	Children    types.List   `tfsdk:"children"`
+	Users       types.List   `tfsdk:"users"`
+}
+
+func (d *RoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *RoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Directus role by `id` or exact `name`, such as the Administrator role created by the installer.",
+
+		Attributes: roleDataSourceAttributes(),
+	}
+
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The UUID of the role. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The exact name of the role. The lookup fails if no role or more than one role has this name.",
+		Optional:            true,
+		Computed:            true,
+	}
+}
+
+// roleDataSourceAttributes returns the computed attributes describing a role, shared by
+// the directus_role and directus_roles data sources.
+func roleDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The UUID of the role.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the role.",
+			Computed:            true,
+		},
+		"icon": schema.StringAttribute{
+			MarkdownDescription: "The name of the Google Material Design Icon assigned to the role.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the role.",
+			Computed:            true,
+		},
+		"parent": schema.StringAttribute{
+			MarkdownDescription: "The UUID of the parent role.",
+			Computed:            true,
+		},
+		"children": schema.ListAttribute{
+			MarkdownDescription: "UUIDs of the roles that inherit from this role.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"users": schema.ListAttribute{
+			MarkdownDescription: "UUIDs of the users assigned to this role.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// ValidateConfig checks that the role is looked up by exactly one of id or name.
+func (d *RoleDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data RoleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Role Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+func (d *RoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role roleAPIResponse
+	if !data.ID.IsNull() {
+		var result struct {
+			Data roleAPIResponse `json:"data"`
+		}
+		if err := d.client.Get(ctx, "roles", data.ID.ValueString(), &result); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Role",
+				fmt.Sprintf("Could not read role %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		role = result.Data
+	} else {
+		var result struct {
+			Data []roleAPIResponse `json:"data"`
+		}
+		params := map[string]string{
+			"filter[name][_eq]": data.Name.ValueString(),
+			"limit":             "-1",
+		}
+		if err := d.client.ListWithParams(ctx, "roles", params, &result); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Role",
+				fmt.Sprintf("Could not look up role %q: %s", data.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		switch len(result.Data) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Role Not Found",
+				fmt.Sprintf("No role is named %q.", data.Name.ValueString()),
+			)
+			return
+		case 1:
+			role = result.Data[0]
+		default:
+			ids := make([]string, len(result.Data))
+			for i, r := range result.Data {
+				ids[i] = r.ID
+			}
+			resp.Diagnostics.AddError(
+				"Ambiguous Role Name",
+				fmt.Sprintf("%d roles are named %q (%s). Look the role up by id instead.",
+					len(ids), data.Name.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, role.toDataSourceModel())...)
+}
+
+// toDataSourceModel converts roleAPIResponse to RoleDataSourceModel.
+func (r *roleAPIResponse) toDataSourceModel() *RoleDataSourceModel {
+	model := r.toModel()
+	return &RoleDataSourceModel{
+		ID:          model.ID,
+		Name:        model.Name,
+		Icon:        model.Icon,
+		Description: model.Description,
+		Parent:      model.Parent,
+		Children:    model.Children,
+		Users:       model.Users,
+	}
+}
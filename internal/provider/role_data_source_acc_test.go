@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_role" "lookup" {
+  name        = "AccTest Role Lookup"
+  description = "Looked up by data sources"
+}
+
+data "directus_role" "by_name" {
+  name = directus_role.lookup.name
+}
+
+data "directus_role" "by_id" {
+  id = directus_role.lookup.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.directus_role.by_name", "id", "directus_role.lookup", "id"),
+					resource.TestCheckResourceAttr("data.directus_role.by_name", "description", "Looked up by data sources"),
+					resource.TestCheckResourceAttr("data.directus_role.by_id", "name", "AccTest Role Lookup"),
+				),
+			},
+		},
+	})
+}
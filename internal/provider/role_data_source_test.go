@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRoleLookupModel returns a data source config model that looks a role up by id or name.
+func newRoleLookupModel(id, name string) *RoleDataSourceModel {
+	return &RoleDataSourceModel{
+		ID:       stringOrNull(id),
+		Name:     stringOrNull(name),
+		Children: types.ListNull(types.StringType),
+		Users:    types.ListNull(types.StringType),
+	}
+}
+
+// readRoleDataSource runs Read for the given lookup and returns the response.
+func readRoleDataSource(t *testing.T, d *RoleDataSource, model *RoleDataSourceModel) *fwdatasource.ReadResponse {
+	t.Helper()
+	schema := getDataSourceSchema(t, d)
+
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, model)}, resp)
+	return resp
+}
+
+func TestRoleDataSource_Metadata(t *testing.T) {
+	d := &RoleDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_role", resp.TypeName)
+}
+
+func TestRoleDataSource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		roleName  string
+		expectErr bool
+	}{
+		{"by id", "role-1", "", false},
+		{"by name", "", "Administrator", false},
+		{"neither", "", "", true},
+		{"both", "role-1", "Administrator", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &RoleDataSource{}
+			schema := getDataSourceSchema(t, d)
+
+			resp := &fwdatasource.ValidateConfigResponse{}
+			d.ValidateConfig(context.Background(), fwdatasource.ValidateConfigRequest{
+				Config: makeDataSourceConfig(t, schema, newRoleLookupModel(tt.id, tt.roleName)),
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestRoleDataSource_Read_ByID(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/roles/role-1", req.URL.Path)
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":       "role-1",
+				"name":     "Editor",
+				"icon":     "edit",
+				"parent":   "role-0",
+				"children": []string{"role-2"},
+				"users":    []string{"user-1", "user-2"},
+			},
+		}), nil
+	})
+
+	resp := readRoleDataSource(t, &RoleDataSource{client: mockClient}, newRoleLookupModel("role-1", ""))
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result RoleDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "Editor", result.Name.ValueString())
+	assert.Equal(t, "edit", result.Icon.ValueString())
+	assert.Equal(t, "role-0", result.Parent.ValueString())
+	assert.True(t, result.Description.IsNull())
+	assert.Len(t, result.Children.Elements(), 1)
+	assert.Len(t, result.Users.Elements(), 2)
+}
+
+func TestRoleDataSource_Read_ByName(t *testing.T) {
+	tests := []struct {
+		name      string
+		roles     []interface{}
+		expectErr string
+	}{
+		{"single match", []interface{}{map[string]interface{}{"id": "role-1", "name": "Administrator"}}, ""},
+		{"no match", []interface{}{}, "Role Not Found"},
+		{"ambiguous", []interface{}{
+			map[string]interface{}{"id": "role-1", "name": "Administrator"},
+			map[string]interface{}{"id": "role-2", "name": "Administrator"},
+		}, "Ambiguous Role Name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/roles", req.URL.Path)
+				assert.Equal(t, "Administrator", req.URL.Query().Get("filter[name][_eq]"))
+				return mockJSONResponse(200, map[string]interface{}{"data": tt.roles}), nil
+			})
+
+			resp := readRoleDataSource(t, &RoleDataSource{client: mockClient}, newRoleLookupModel("", "Administrator"))
+
+			if tt.expectErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectErr, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+			var result RoleDataSourceModel
+			resp.State.Get(context.Background(), &result)
+			assert.Equal(t, "role-1", result.ID.ValueString())
+			assert.True(t, result.Users.IsNull())
+		})
+	}
+}
+
+func TestRoleDataSource_Read_Error(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return mockErrorResponse(403, "Forbidden"), nil
+	})
+
+	resp := readRoleDataSource(t, &RoleDataSource{client: mockClient}, newRoleLookupModel("missing", ""))
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -3,10 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +23,8 @@ var _ resource.ResourceWithConfigure = &RoleResource{}
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RoleResource{}
 var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithModifyPlan = &RoleResource{}
+var _ resource.ResourceWithValidateConfig = &RoleResource{}
 
 // NewRoleResource creates a new role resource.
 func NewRoleResource() resource.Resource {
@@ -29,6 +34,10 @@ func NewRoleResource() resource.Resource {
 // RoleResource defines the resource implementation.
 type RoleResource struct {
 	client *client.Client
+
+	// parents records the planned parents of the current plan. It is provided by the
+	// provider and is nil when the resource is created outside of it.
+	parents *roleParentRegistry
 }
 
 // RoleResourceModel describes the resource data model.
@@ -41,6 +50,10 @@ type RoleResourceModel struct {
 	Parent      types.String `tfsdk:"parent"`
 	Children    types.List   `tfsdk:"children"` // List of child role UUIDs (computed)
 	Users       types.List   `tfsdk:"users"`    // List of user UUIDs (computed)
+
+	// Terraform-only attributes controlling what happens to users on destroy
+	ReassignUsersTo         types.String `tfsdk:"reassign_users_to"`
+	PreventDestroyWithUsers types.Bool   `tfsdk:"prevent_destroy_with_users"`
 }
 
 func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,7 +91,7 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			},
 			"parent": schema.StringAttribute{
 				MarkdownDescription: "The ID of the parent role. Child roles inherit permissions from their parent. " +
-					"Note: Circular references are not allowed.",
+					"Circular references are not allowed and are rejected at plan time.",
 				Optional: true,
 			},
 			"children": schema.ListAttribute{
@@ -97,10 +110,129 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"reassign_users_to": schema.StringAttribute{
+				MarkdownDescription: "The ID of a role to move the users of this role to before it is destroyed. " +
+					"Without it, users are left without a role.",
+				Optional: true,
+			},
+			"prevent_destroy_with_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the role fails while users are still assigned to it. " +
+					"Conflicts with `reassign_users_to`. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
+// ValidateConfig checks that users are either reassigned or protected, not both.
+func (r *RoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RoleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ReassignUsersTo.IsNull() && data.PreventDestroyWithUsers.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prevent_destroy_with_users"),
+			"Conflicting Role Destroy Options",
+			"prevent_destroy_with_users cannot be enabled together with reassign_users_to, since reassigned users do not lose their role.",
+		)
+	}
+}
+
+// ModifyPlan reports the users affected by destroying the role, and fails the plan when the
+// planned parent would make the role its own ancestor. The parent chain is walked through
+// the API, preferring the planned parents of other roles already planned in the current plan.
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		r.modifyDestroyPlan(ctx, req, resp)
+		return
+	}
+	if r.client == nil {
+		return
+	}
+
+	var plan RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ID.IsUnknown() && !plan.ReassignUsersTo.IsUnknown() && plan.ReassignUsersTo.ValueString() == plan.ID.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reassign_users_to"),
+			"Invalid Role Reassignment",
+			"Users cannot be reassigned to the role that is being destroyed.",
+		)
+	}
+
+	// New roles cannot be part of a cycle, since no existing role can reference them yet.
+	if plan.ID.IsUnknown() || plan.ID.IsNull() || plan.Parent.IsUnknown() {
+		return
+	}
+
+	r.parents.set(plan.ID.ValueString(), roleNode{Name: plan.Name.ValueString(), Parent: plan.Parent.ValueString()})
+
+	cycle, err := r.findParentCycle(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("parent"),
+			"Could Not Check Role Parents",
+			"Could not walk the parent chain of role "+plan.Name.ValueString()+" to check for circular references: "+err.Error(),
+		)
+		return
+	}
+	if cycle != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent"),
+			"Circular Role Parent",
+			"Setting this parent would make role "+plan.Name.ValueString()+" its own ancestor: "+formatRoleCycle(cycle),
+		)
+	}
+}
+
+// modifyDestroyPlan warns about, or blocks, destroying a role that users are assigned to.
+func (r *RoleResource) modifyDestroyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count := len(state.Users.Elements())
+	if count == 0 {
+		return
+	}
+
+	switch {
+	case !state.ReassignUsersTo.IsNull():
+		resp.Diagnostics.AddWarning(
+			"Role Users Will Be Reassigned",
+			fmt.Sprintf("Destroying role %s moves its %d user(s) to role %s.", state.Name.ValueString(), count, state.ReassignUsersTo.ValueString()),
+		)
+	case state.PreventDestroyWithUsers.ValueBool():
+		resp.Diagnostics.AddError(
+			"Role Has Users",
+			fmt.Sprintf("Role %s cannot be destroyed while %d user(s) are assigned to it. "+
+				"Reassign the users, or set prevent_destroy_with_users = false and apply it first.", state.Name.ValueString(), count),
+		)
+	default:
+		resp.Diagnostics.AddWarning(
+			"Role Users Will Lose Their Role",
+			fmt.Sprintf("Destroying role %s leaves %d user(s) without a role. Set reassign_users_to to move them to another role.",
+				state.Name.ValueString(), count),
+		)
+	}
+}
+
 func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -193,6 +325,11 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Children = readRole.Children
 	data.Users = readRole.Users
 
+	// prevent_destroy_with_users only exists in Terraform and is null after import
+	if data.PreventDestroyWithUsers.IsNull() {
+		data.PreventDestroyWithUsers = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -258,6 +395,40 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		}
 	}
 
+	// Reassign or protect the users of the role, based on their current assignment
+	if !data.ReassignUsersTo.IsNull() || data.PreventDestroyWithUsers.ValueBool() {
+		users, err := r.listRoleUsers(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Role",
+				"Could not list the users of role ID "+data.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		if len(users) > 0 && data.ReassignUsersTo.IsNull() {
+			resp.Diagnostics.AddError(
+				"Role Has Users",
+				fmt.Sprintf("Role %s cannot be destroyed while %d user(s) are assigned to it.", data.Name.ValueString(), len(users)),
+			)
+			return
+		}
+
+		if len(users) > 0 {
+			items := make([]map[string]interface{}, len(users))
+			for i, user := range users {
+				items[i] = map[string]interface{}{"id": user, "role": data.ReassignUsersTo.ValueString()}
+			}
+			if err := r.client.UpdateMany(ctx, "users", items, nil); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reassigning Role Users",
+					"Could not move the users of role ID "+data.ID.ValueString()+" to role "+data.ReassignUsersTo.ValueString()+": "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
 	// Delete role via API
 	if err := r.client.Delete(ctx, "roles", data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
@@ -272,6 +443,121 @@ func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// listRoleUsers returns the IDs of the users currently assigned to a role.
+func (r *RoleResource) listRoleUsers(ctx context.Context, roleID string) ([]string, error) {
+	var result struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+
+	params := map[string]string{
+		"filter[role][_eq]": roleID,
+		"fields":            "id",
+		"limit":             "-1",
+	}
+	if err := r.client.ListWithParams(ctx, "users", params, &result); err != nil {
+		return nil, err
+	}
+
+	users := make([]string, len(result.Data))
+	for i, user := range result.Data {
+		users[i] = user.ID
+	}
+	return users, nil
+}
+
+// roleNode is a role in the parent chain.
+type roleNode struct {
+	ID     string
+	Name   string
+	Parent string
+}
+
+// roleParentRegistry records the planned parents of roles, so that a cycle created by
+// changing several roles in one plan is detected once the last of them is planned.
+// The provider creates a new registry each time it is configured, i.e. for every plan
+// and apply, so entries never outlive the plan that recorded them. A nil registry
+// records nothing.
+type roleParentRegistry struct {
+	mu    sync.Mutex
+	roles map[string]roleNode
+}
+
+// newRoleParentRegistry creates an empty registry.
+func newRoleParentRegistry() *roleParentRegistry {
+	return &roleParentRegistry{roles: make(map[string]roleNode)}
+}
+
+func (p *roleParentRegistry) set(id string, node roleNode) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	node.ID = id
+	p.roles[id] = node
+}
+
+func (p *roleParentRegistry) get(id string) (roleNode, bool) {
+	if p == nil {
+		return roleNode{}, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	node, ok := p.roles[id]
+	return node, ok
+}
+
+// maxRoleDepth bounds the parent walk in case the existing hierarchy is already corrupt.
+const maxRoleDepth = 100
+
+// findParentCycle walks up from the planned parent of a role. It returns the chain from the
+// role back to itself if the role is reached again, or nil if the chain ends.
+func (r *RoleResource) findParentCycle(ctx context.Context, plan RoleResourceModel) ([]roleNode, error) {
+	self := roleNode{ID: plan.ID.ValueString(), Name: plan.Name.ValueString(), Parent: plan.Parent.ValueString()}
+	chain := []roleNode{self}
+	seen := map[string]bool{self.ID: true}
+
+	for current := self.Parent; current != ""; {
+		if current == self.ID {
+			return append(chain, self), nil
+		}
+		if seen[current] || len(chain) > maxRoleDepth {
+			// A cycle further up that this role is not part of; Directus will report it.
+			return nil, nil
+		}
+		seen[current] = true
+
+		node, ok := r.parents.get(current)
+		if !ok {
+			var result struct {
+				Data roleAPIResponse `json:"data"`
+			}
+			if err := r.client.GetWithParams(ctx, "roles", current, map[string]string{"fields": "id,name,parent"}, &result); err != nil {
+				return nil, err
+			}
+			node = roleNode{ID: current, Name: result.Data.Name, Parent: result.Data.Parent}
+		}
+
+		chain = append(chain, node)
+		current = node.Parent
+	}
+
+	return nil, nil
+}
+
+// formatRoleCycle spells out a cycle as "A (id) -> B (id) -> A (id)".
+func formatRoleCycle(cycle []roleNode) string {
+	parts := make([]string, len(cycle))
+	for i, node := range cycle {
+		parts[i] = fmt.Sprintf("%s (%s)", node.Name, node.ID)
+	}
+	return strings.Join(parts, " -> ")
+}
+
 // roleAPIResponse represents the API response for role operations with plain Go types.
 // Per the Directus Roles API, response fields are: id, name, icon, description, parent,
 // children, policies, users. Note: admin_access/app_access do NOT exist on roles — they
@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccRole_basic(t *testing.T) {
@@ -248,3 +256,163 @@ resource "directus_role" "role_b" {
 		},
 	})
 }
+
+// TestAccRole_circularParent verifies that a parent that would make a role its own
+// ancestor fails the plan before anything is changed.
+func TestAccRole_circularParent(t *testing.T) {
+	// The child ID is only known after the first step, so it is passed as a variable.
+	variables := config.Variables{"top_parent": config.StringVariable("")}
+
+	const roles = `
+variable "top_parent" {
+  type = string
+}
+
+resource "directus_role" "top" {
+  name   = "AccTest Cycle Top"
+  parent = var.top_parent == "" ? null : var.top_parent
+}
+
+resource "directus_role" "child" {
+  name   = "AccTest Cycle Child"
+  parent = directus_role.top.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_role", "roles"),
+		Steps: []resource.TestStep{
+			{
+				Config:          testAccProviderConfig() + roles,
+				ConfigVariables: variables,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["directus_role.child"]
+					if !ok {
+						return fmt.Errorf("resource not found: directus_role.child")
+					}
+					variables["top_parent"] = config.StringVariable(rs.Primary.ID)
+					return nil
+				},
+			},
+			{
+				Config:          testAccProviderConfig() + roles,
+				ConfigVariables: variables,
+				PlanOnly:        true,
+				ExpectError:     regexp.MustCompile(`Circular Role Parent`),
+			},
+			// Clear the variable again so the roles can be destroyed
+			{
+				PreConfig:       func() { variables["top_parent"] = config.StringVariable("") },
+				Config:          testAccProviderConfig() + roles,
+				ConfigVariables: variables,
+				PlanOnly:        true,
+			},
+		},
+	})
+}
+
+// TestAccRole_reassignUsers verifies that users of a destroyed role are moved to
+// the role named in reassign_users_to.
+func TestAccRole_reassignUsers(t *testing.T) {
+	var userID string
+
+	const target = `
+resource "directus_role" "target" {
+  name = "AccTest Reassign Target"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_role", "roles"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + target + `
+resource "directus_role" "source" {
+  name              = "AccTest Reassign Source"
+  reassign_users_to = directus_role.target.id
+}
+`,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["directus_role.source"]
+					if !ok {
+						return fmt.Errorf("resource not found: directus_role.source")
+					}
+					userID = testAccCreateUser(t, "acctest-reassign@example.com")
+					testAccSetUserRole(t, userID, rs.Primary.ID)
+					return nil
+				},
+			},
+			// Removing the source role moves its user to the target role
+			{
+				Config: testAccProviderConfig() + target,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["directus_role.target"]
+					if !ok {
+						return fmt.Errorf("resource not found: directus_role.target")
+					}
+					if role := testAccUserRole(t, userID); role != rs.Primary.ID {
+						return fmt.Errorf("expected user to be reassigned to role %s, got %q", rs.Primary.ID, role)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// testAccSetUserRole assigns a user to a role directly through the API.
+func testAccSetUserRole(t *testing.T, userID, roleID string) {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]interface{}{"role": roleID})
+	req, err := http.NewRequest("PATCH", os.Getenv("DIRECTUS_ENDPOINT")+"/users/"+userID, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create user update request: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv("DIRECTUS_TOKEN"))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to assign test user role: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Directus returned HTTP %d when assigning test user role", resp.StatusCode)
+	}
+}
+
+// testAccUserRole returns the role UUID a user is currently assigned to.
+func testAccUserRole(t *testing.T, userID string) string {
+	t.Helper()
+
+	req, err := http.NewRequest("GET", os.Getenv("DIRECTUS_ENDPOINT")+"/users/"+userID+"?fields=role", nil)
+	if err != nil {
+		t.Fatalf("Failed to create user request: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv("DIRECTUS_TOKEN"))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to read test user: %s", err)
+	}
+	defer resp.Body.Close()
+
+	var user struct {
+		Data struct {
+			Role *string `json:"role"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		t.Fatalf("Failed to decode test user: %s", err)
+	}
+	if user.Data.Role == nil {
+		return ""
+	}
+	return *user.Data.Role
+}
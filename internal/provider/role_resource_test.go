@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -312,3 +316,305 @@ func TestRoleResource_Delete(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+// ---------------------------------------------------------------------------
+// ModifyPlan: circular parents
+// ---------------------------------------------------------------------------
+
+// newRolePlanModel returns a planned role with the given ID and parent ("" for none).
+func newRolePlanModel(id, name, parent string) *RoleResourceModel {
+	model := &RoleResourceModel{
+		ID:          types.StringValue(id),
+		Name:        types.StringValue(name),
+		Icon:        types.StringValue("supervised_user_circle"),
+		Description: types.StringNull(),
+		Parent:      stringOrNull(parent),
+		Children:    types.ListNull(types.StringType),
+		Users:       types.ListNull(types.StringType),
+	}
+	if id == "" {
+		model.ID = types.StringUnknown()
+	}
+	return model
+}
+
+// mockRoleHierarchy serves GET /roles/{id} from a map of role ID to name and parent.
+func mockRoleHierarchy(t *testing.T, roles map[string][2]string) *RoleResource {
+	return &RoleResource{parents: newRoleParentRegistry(), client: newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "id,name,parent", req.URL.Query().Get("fields"))
+
+		id := strings.TrimPrefix(req.URL.Path, "/roles/")
+		role, ok := roles[id]
+		if !ok {
+			return mockErrorResponse(403, "You don't have permission to access this."), nil
+		}
+
+		var parent interface{}
+		if role[1] != "" {
+			parent = role[1]
+		}
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": id, "name": role[0], "parent": parent},
+		}), nil
+	})}
+}
+
+func modifyRolePlan(t *testing.T, r *RoleResource, model *RoleResourceModel) *fwresource.ModifyPlanResponse {
+	t.Helper()
+
+	schema := getResourceSchema(t, r)
+	plan := makePlan(t, schema, model)
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan}, resp)
+	return resp
+}
+
+func TestRoleResource_ModifyPlan_ParentCycle(t *testing.T) {
+	roles := map[string][2]string{
+		"role-a": {"Admin", ""},
+		"role-b": {"Editor", "role-c"},
+		"role-c": {"Reviewer", "role-a"},
+		"role-d": {"Guest", ""},
+	}
+
+	tests := []struct {
+		name      string
+		model     *RoleResourceModel
+		wantCycle string
+	}{
+		{"no parent", newRolePlanModel("role-a", "Admin", ""), ""},
+		{"chain ends", newRolePlanModel("role-a", "Admin", "role-d"), ""},
+		{"new role", newRolePlanModel("", "Intern", "role-b"), ""},
+		{"own parent", newRolePlanModel("role-a", "Admin", "role-a"), "Admin (role-a) -> Admin (role-a)"},
+		{"cycle through the API", newRolePlanModel("role-a", "Admin", "role-b"),
+			"Admin (role-a) -> Editor (role-b) -> Reviewer (role-c) -> Admin (role-a)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := modifyRolePlan(t, mockRoleHierarchy(t, roles), tt.model)
+
+			if tt.wantCycle == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantCycle)
+		})
+	}
+}
+
+func TestRoleResource_ModifyPlan_ParentCycleAcrossPlannedRoles(t *testing.T) {
+	// Both roles are top-level in Directus; the plan swaps them under each other.
+	r := mockRoleHierarchy(t, map[string][2]string{
+		"role-a": {"Admin", ""},
+		"role-b": {"Editor", ""},
+	})
+
+	resp := modifyRolePlan(t, r, newRolePlanModel("role-b", "Editor", "role-a"))
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	resp = modifyRolePlan(t, r, newRolePlanModel("role-a", "Admin", "role-b"))
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "Admin (role-a) -> Editor (role-b) -> Admin (role-a)")
+}
+
+// configureProvider runs Configure as Terraform does at the start of every plan and apply.
+func configureProvider(t *testing.T, p *DirectusProvider) {
+	t.Helper()
+
+	schemaResp := &fwprovider.SchemaResponse{}
+	p.Schema(context.Background(), fwprovider.SchemaRequest{}, schemaResp)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"endpoint": tftypes.NewValue(tftypes.String, "http://example.com"),
+			"token":    tftypes.NewValue(tftypes.String, "test-token"),
+		}),
+	}
+
+	resp := &fwprovider.ConfigureResponse{}
+	p.Configure(context.Background(), fwprovider.ConfigureRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Configure diagnostics: %v", resp.Diagnostics)
+}
+
+func TestRoleResource_ModifyPlan_IgnoresEarlierPlans(t *testing.T) {
+	hierarchy := mockRoleHierarchy(t, map[string][2]string{
+		"role-a": {"Admin", ""},
+		"role-b": {"Editor", ""},
+	})
+	p := &DirectusProvider{}
+	newRole := func() *RoleResource {
+		r := p.newRoleResource().(*RoleResource)
+		r.client = hierarchy.client
+		return r
+	}
+
+	// An earlier plan moved role-b under role-a but was never applied.
+	configureProvider(t, p)
+	resp := modifyRolePlan(t, newRole(), newRolePlanModel("role-b", "Editor", "role-a"))
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	configureProvider(t, p)
+	resp = modifyRolePlan(t, newRole(), newRolePlanModel("role-a", "Admin", "role-b"))
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+}
+
+func TestRoleResource_ModifyPlan_UnreadableParent(t *testing.T) {
+	resp := modifyRolePlan(t, mockRoleHierarchy(t, nil), newRolePlanModel("role-a", "Admin", "role-x"))
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Len(t, resp.Diagnostics.Warnings(), 1)
+}
+
+// ---------------------------------------------------------------------------
+// Users on destroy
+// ---------------------------------------------------------------------------
+
+func TestRoleResource_ValidateConfig(t *testing.T) {
+	r := &RoleResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		reassign  types.String
+		prevent   types.Bool
+		expectErr bool
+	}{
+		{"defaults", types.StringNull(), types.BoolNull(), false},
+		{"reassign", types.StringValue("role-b"), types.BoolNull(), false},
+		{"prevent", types.StringNull(), types.BoolValue(true), false},
+		{"reassign and prevent", types.StringValue("role-b"), types.BoolValue(true), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newRolePlanModel("", "Editor", "")
+			model.ID = types.StringNull()
+			model.Icon = types.StringNull()
+			model.ReassignUsersTo = tt.reassign
+			model.PreventDestroyWithUsers = tt.prevent
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestRoleResource_ModifyPlan_ReassignToSelf(t *testing.T) {
+	model := newRolePlanModel("role-a", "Admin", "")
+	model.ReassignUsersTo = types.StringValue("role-a")
+
+	resp := modifyRolePlan(t, mockRoleHierarchy(t, nil), model)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestRoleResource_ModifyPlan_Destroy(t *testing.T) {
+	tests := []struct {
+		name        string
+		users       []string
+		reassign    types.String
+		prevent     bool
+		wantError   bool
+		wantWarning string
+	}{
+		{"no users", nil, types.StringNull(), true, false, ""},
+		{"users lose their role", []string{"user-1", "user-2"}, types.StringNull(), false, false, "leaves 2 user(s) without a role"},
+		{"users are reassigned", []string{"user-1"}, types.StringValue("role-b"), false, false, "moves its 1 user(s) to role role-b"},
+		{"destroy is blocked", []string{"user-1"}, types.StringNull(), true, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RoleResource{}
+			schema := getResourceSchema(t, r)
+
+			model := newRolePlanModel("role-a", "Editor", "")
+			model.Users = stringListOrNull(tt.users)
+			model.ReassignUsersTo = tt.reassign
+			model.PreventDestroyWithUsers = types.BoolValue(tt.prevent)
+
+			plan := tfsdk.Plan{Schema: schema, Raw: makeNullState(t, schema).Raw}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: makeState(t, schema, model)}, resp)
+
+			assert.Equal(t, tt.wantError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tt.wantWarning == "" {
+				assert.Empty(t, resp.Diagnostics.Warnings())
+				return
+			}
+			require.Len(t, resp.Diagnostics.Warnings(), 1)
+			assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), tt.wantWarning)
+		})
+	}
+}
+
+func TestRoleResource_Delete_ReassignsUsers(t *testing.T) {
+	var reassigned, deleted bool
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/users":
+			assert.Equal(t, "role-a", req.URL.Query().Get("filter[role][_eq]"))
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []interface{}{map[string]interface{}{"id": "user-1"}, map[string]interface{}{"id": "user-2"}},
+			}), nil
+		case req.Method == "PATCH" && req.URL.Path == "/users":
+			var body []map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, []map[string]interface{}{
+				{"id": "user-1", "role": "role-b"},
+				{"id": "user-2", "role": "role-b"},
+			}, body)
+			assert.False(t, deleted, "users must be moved before the role is deleted")
+			reassigned = true
+			return mockJSONResponse(200, map[string]interface{}{"data": body}), nil
+		case req.Method == "DELETE" && req.URL.Path == "/roles/role-a":
+			deleted = true
+			return mockJSONResponse(204, nil), nil
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &RoleResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newRolePlanModel("role-a", "Editor", "")
+	model.ReassignUsersTo = types.StringValue("role-b")
+	model.PreventDestroyWithUsers = types.BoolValue(false)
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+	assert.True(t, reassigned)
+	assert.True(t, deleted)
+}
+
+func TestRoleResource_Delete_PreventedWithUsers(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method != "GET" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		}
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []interface{}{map[string]interface{}{"id": "user-1"}},
+		}), nil
+	})
+
+	r := &RoleResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newRolePlanModel("role-a", "Editor", "")
+	model.PreventDestroyWithUsers = types.BoolValue(true)
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &RolesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &RolesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &RolesDataSource{}
+)
+
+// NewRolesDataSource creates a new roles data source.
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client *client.Client
+}
+
+// RolesDataSourceModel describes the data source data model.
+type RolesDataSourceModel struct {
+	Filter types.String          `tfsdk:"filter"`
+	Sort   types.List            `tfsdk:"sort"`
+	Limit  types.Int64           `tfsdk:"limit"`
+	Roles  []RoleDataSourceModel `tfsdk:"roles"`
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Directus roles, optionally filtered, sorted and limited on the server.",
+
+		Attributes: listQueryAttributes("roles"),
+	}
+
+	resp.Schema.Attributes["roles"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching roles.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: roleDataSourceAttributes(),
+		},
+	}
+}
+
+// ValidateConfig checks the filter expression and limit.
+func (d *RolesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data RolesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateJSONObject(data.Filter); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+	}
+	if err := validatePositiveInt64(data.Limit); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid Limit", err.Error())
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Data []roleAPIResponse `json:"data"`
+	}
+	if err := d.client.ListWithParams(ctx, "roles", listQueryParams(data.Filter, data.Sort, data.Limit), &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Roles",
+			fmt.Sprintf("Could not list roles: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Roles = make([]RoleDataSourceModel, len(result.Data))
+	for i, role := range result.Data {
+		data.Roles[i] = *role.toDataSourceModel()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
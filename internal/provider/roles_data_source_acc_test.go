@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_role" "first" {
+  name = "AccTest Roles List A"
+}
+
+resource "directus_role" "second" {
+  name = "AccTest Roles List B"
+}
+
+data "directus_roles" "test" {
+  filter = jsonencode({ name = { _starts_with = "AccTest Roles List" } })
+  sort   = ["-name"]
+
+  depends_on = [directus_role.first, directus_role.second]
+}
+
+data "directus_roles" "limited" {
+  filter = jsonencode({ name = { _starts_with = "AccTest Roles List" } })
+  sort   = ["name"]
+  limit  = 1
+
+  depends_on = [directus_role.first, directus_role.second]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.directus_roles.test", "roles.#", "2"),
+					resource.TestCheckResourceAttrPair("data.directus_roles.test", "roles.0.id", "directus_role.second", "id"),
+					resource.TestCheckResourceAttr("data.directus_roles.limited", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.directus_roles.limited", "roles.0.name", "AccTest Roles List A"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolesDataSource_Metadata(t *testing.T) {
+	d := &RolesDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_roles", resp.TypeName)
+}
+
+func TestRolesDataSource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    types.String
+		limit     types.Int64
+		expectErr bool
+	}{
+		{"no query", types.StringNull(), types.Int64Null(), false},
+		{"filter and limit", types.StringValue(`{"name": {"_starts_with": "App"}}`), types.Int64Value(10), false},
+		{"filter not an object", types.StringValue(`["name"]`), types.Int64Null(), true},
+		{"zero limit", types.StringNull(), types.Int64Value(0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &RolesDataSource{}
+			schema := getDataSourceSchema(t, d)
+
+			resp := &fwdatasource.ValidateConfigResponse{}
+			d.ValidateConfig(context.Background(), fwdatasource.ValidateConfigRequest{
+				Config: makeDataSourceConfig(t, schema, &RolesDataSourceModel{
+					Filter: tt.filter,
+					Sort:   types.ListNull(types.StringType),
+					Limit:  tt.limit,
+				}),
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestRolesDataSource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/roles", req.URL.Path)
+		assert.Equal(t, `{"name":{"_starts_with":"App"}}`, req.URL.Query().Get("filter"))
+		assert.Equal(t, "-name", req.URL.Query().Get("sort"))
+		assert.Equal(t, "-1", req.URL.Query().Get("limit"))
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []interface{}{
+				map[string]interface{}{"id": "role-2", "name": "App Viewer", "users": []string{"user-1"}},
+				map[string]interface{}{"id": "role-1", "name": "App Editor", "parent": "role-0"},
+			},
+		}), nil
+	})
+
+	d := &RolesDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, &RolesDataSourceModel{
+		Filter: types.StringValue(`{"name":{"_starts_with":"App"}}`),
+		Sort:   stringListOrNull([]string{"-name"}),
+		Limit:  types.Int64Null(),
+	})}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result RolesDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	require.Len(t, result.Roles, 2)
+	assert.Equal(t, "role-2", result.Roles[0].ID.ValueString())
+	assert.Equal(t, []string{"user-1"}, stringListValues(result.Roles[0].Users))
+	assert.Equal(t, "role-0", result.Roles[1].Parent.ValueString())
+}
+
+func TestRolesDataSource_Read_Error(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return mockErrorResponse(403, "You don't have permission to access this."), nil
+	})
+
+	d := &RolesDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, &RolesDataSourceModel{
+		Filter: types.StringNull(),
+		Sort:   types.ListNull(types.StringType),
+		Limit:  types.Int64Null(),
+	})}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Error Listing Roles", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,447 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &ServerInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServerInfoDataSource{}
+)
+
+// NewServerInfoDataSource creates a new server info data source.
+func NewServerInfoDataSource() datasource.DataSource {
+	return &ServerInfoDataSource{}
+}
+
+// ServerInfoDataSource defines the data source implementation.
+type ServerInfoDataSource struct {
+	client *client.Client
+}
+
+// ServerInfoDataSourceModel describes the data source data model.
+type ServerInfoDataSourceModel struct {
+	Version         types.String `tfsdk:"version"`
+	Project         types.Object `tfsdk:"project"`
+	RateLimit       types.Object `tfsdk:"rate_limit"`
+	RateLimitGlobal types.Object `tfsdk:"rate_limit_global"`
+	QueryLimit      types.Object `tfsdk:"query_limit"`
+	ExtensionsLimit types.Int64  `tfsdk:"extensions_limit"`
+	Websocket       types.Object `tfsdk:"websocket"`
+	Cache           types.Object `tfsdk:"cache"`
+	InfoJSON        types.String `tfsdk:"info_json"`
+}
+
+// serverProjectAttrTypes are the attribute types of the project object.
+var serverProjectAttrTypes = map[string]attr.Type{
+	"name":                types.StringType,
+	"descriptor":          types.StringType,
+	"logo":                types.StringType,
+	"color":               types.StringType,
+	"default_language":    types.StringType,
+	"public_note":         types.StringType,
+	"public_registration": types.BoolType,
+}
+
+// serverRateLimitAttrTypes are the attribute types of the rate_limit and
+// rate_limit_global objects.
+var serverRateLimitAttrTypes = map[string]attr.Type{
+	"enabled":  types.BoolType,
+	"points":   types.Int64Type,
+	"duration": types.Int64Type,
+}
+
+// serverQueryLimitAttrTypes are the attribute types of the query_limit object.
+var serverQueryLimitAttrTypes = map[string]attr.Type{
+	"default": types.Int64Type,
+	"max":     types.Int64Type,
+}
+
+// serverWebsocketAttrTypes are the attribute types of the websocket object.
+var serverWebsocketAttrTypes = map[string]attr.Type{
+	"enabled":      types.BoolType,
+	"rest_path":    types.StringType,
+	"graphql_path": types.StringType,
+	"heartbeat":    types.Int64Type,
+}
+
+// serverCacheAttrTypes are the attribute types of the cache object.
+var serverCacheAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+	"store":   types.StringType,
+}
+
+func (d *ServerInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+func (d *ServerInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	rateLimitAttributes := map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the rate limiter is enabled.",
+			Computed:            true,
+		},
+		"points": schema.Int64Attribute{
+			MarkdownDescription: "The number of requests allowed per duration.",
+			Computed:            true,
+		},
+		"duration": schema.Int64Attribute{
+			MarkdownDescription: "The duration in seconds the points are counted over.",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Information about the Directus instance from `/server/info`. " +
+			"Directus only includes some of it for authenticated users or admins; attributes that are not reported are null.",
+
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The Directus version, e.g. `11.1.0`. Only reported to admins.",
+				Computed:            true,
+			},
+			"project": schema.SingleNestedAttribute{
+				MarkdownDescription: "The project settings shown on the login page.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the project.",
+						Computed:            true,
+					},
+					"descriptor": schema.StringAttribute{
+						MarkdownDescription: "The descriptor shown below the project name.",
+						Computed:            true,
+					},
+					"logo": schema.StringAttribute{
+						MarkdownDescription: "The UUID of the project logo file.",
+						Computed:            true,
+					},
+					"color": schema.StringAttribute{
+						MarkdownDescription: "The project color.",
+						Computed:            true,
+					},
+					"default_language": schema.StringAttribute{
+						MarkdownDescription: "The default language of the Data Studio.",
+						Computed:            true,
+					},
+					"public_note": schema.StringAttribute{
+						MarkdownDescription: "The note shown on the login page.",
+						Computed:            true,
+					},
+					"public_registration": schema.BoolAttribute{
+						MarkdownDescription: "Whether users can register themselves.",
+						Computed:            true,
+					},
+				},
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				MarkdownDescription: "The per-IP rate limiter settings. Null if not reported to the token.",
+				Computed:            true,
+				Attributes:          rateLimitAttributes,
+			},
+			"rate_limit_global": schema.SingleNestedAttribute{
+				MarkdownDescription: "The global rate limiter settings. Null if not reported to the token.",
+				Computed:            true,
+				Attributes:          rateLimitAttributes,
+			},
+			"query_limit": schema.SingleNestedAttribute{
+				MarkdownDescription: "The number of items returned per request. Null if not reported to the token.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"default": schema.Int64Attribute{
+						MarkdownDescription: "The number of items returned when no limit is requested.",
+						Computed:            true,
+					},
+					"max": schema.Int64Attribute{
+						MarkdownDescription: "The maximum limit that can be requested. `-1` means unlimited.",
+						Computed:            true,
+					},
+				},
+			},
+			"extensions_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of extensions that can be installed. Null if unlimited or not reported to the token.",
+				Computed:            true,
+			},
+			"websocket": schema.SingleNestedAttribute{
+				MarkdownDescription: "The WebSocket settings. Null if not reported to the token.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether WebSockets are enabled.",
+						Computed:            true,
+					},
+					"rest_path": schema.StringAttribute{
+						MarkdownDescription: "The path of the REST WebSocket endpoint. Null if disabled.",
+						Computed:            true,
+					},
+					"graphql_path": schema.StringAttribute{
+						MarkdownDescription: "The path of the GraphQL WebSocket endpoint. Null if disabled.",
+						Computed:            true,
+					},
+					"heartbeat": schema.Int64Attribute{
+						MarkdownDescription: "The heartbeat interval in seconds. Null if disabled.",
+						Computed:            true,
+					},
+				},
+			},
+			"cache": schema.SingleNestedAttribute{
+				MarkdownDescription: "The data cache settings. Null if not reported to the token. " +
+					"Directus 11 does not report cache settings, so this is always null there.",
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the data cache is enabled.",
+						Computed:            true,
+					},
+					"store": schema.StringAttribute{
+						MarkdownDescription: "The cache store, e.g. `memory` or `redis`. Null if disabled.",
+						Computed:            true,
+					},
+				},
+			},
+			"info_json": schema.StringAttribute{
+				MarkdownDescription: "The complete response as JSON, including information not exposed as attributes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ServerInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ServerInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var result struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := d.client.ServerInfo(ctx, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Server Info",
+			fmt.Sprintf("Could not read server info: %s", err.Error()),
+		)
+		return
+	}
+
+	var info serverInfoAPIResponse
+	if err := json.Unmarshal(result.Data, &info); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Server Info",
+			fmt.Sprintf("Could not decode server info: %s", err.Error()),
+		)
+		return
+	}
+
+	model, err := info.toModel(result.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Server Info",
+			fmt.Sprintf("Could not decode server info: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// serverInfoAPIResponse is the data returned by GET /server/info. Settings that can be
+// disabled (rate limiters, websockets, cache) are reported either as false or as an object, so
+// they are decoded separately.
+type serverInfoAPIResponse struct {
+	Version string `json:"version,omitempty"`
+	Project *struct {
+		ProjectName        string `json:"project_name,omitempty"`
+		ProjectDescriptor  string `json:"project_descriptor,omitempty"`
+		ProjectLogo        string `json:"project_logo,omitempty"`
+		ProjectColor       string `json:"project_color,omitempty"`
+		DefaultLanguage    string `json:"default_language,omitempty"`
+		PublicNote         string `json:"public_note,omitempty"`
+		PublicRegistration bool   `json:"public_registration,omitempty"`
+	} `json:"project,omitempty"`
+	RateLimit       json.RawMessage `json:"rateLimit,omitempty"`
+	RateLimitGlobal json.RawMessage `json:"rateLimitGlobal,omitempty"`
+	QueryLimit      *struct {
+		Default *int64 `json:"default,omitempty"`
+		Max     *int64 `json:"max,omitempty"`
+	} `json:"queryLimit,omitempty"`
+	Extensions *struct {
+		Limit *int64 `json:"limit,omitempty"`
+	} `json:"extensions,omitempty"`
+	Websocket json.RawMessage `json:"websocket,omitempty"`
+	Cache     json.RawMessage `json:"cache,omitempty"`
+}
+
+// serverRateLimitResponse is an enabled rate limiter.
+type serverRateLimitResponse struct {
+	Points   *int64 `json:"points,omitempty"`
+	Duration *int64 `json:"duration,omitempty"`
+}
+
+// serverWebsocketResponse is the websocket object returned when WebSockets are enabled.
+type serverWebsocketResponse struct {
+	REST      json.RawMessage `json:"rest,omitempty"`
+	GraphQL   json.RawMessage `json:"graphql,omitempty"`
+	Heartbeat json.RawMessage `json:"heartbeat,omitempty"`
+}
+
+// serverCacheResponse is the cache object returned when the data cache is enabled.
+type serverCacheResponse struct {
+	Store string `json:"store,omitempty"`
+}
+
+// serverWebsocketEndpoint is an enabled REST or GraphQL WebSocket endpoint.
+type serverWebsocketEndpoint struct {
+	Path string `json:"path,omitempty"`
+}
+
+// decodeToggle decodes a setting that Directus reports as false when disabled and as an
+// object when enabled. present is false if the setting is missing or null.
+func decodeToggle(raw json.RawMessage, v interface{}) (present, enabled bool, err error) {
+	switch string(raw) {
+	case "", "null":
+		return false, false, nil
+	case "false":
+		return true, false, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return true, false, err
+	}
+	return true, true, nil
+}
+
+// int64OrNull returns a types.Int64 that is null when the pointer is nil.
+func int64OrNull(v *int64) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*v)
+}
+
+// toModel converts serverInfoAPIResponse to ServerInfoDataSourceModel. raw is the
+// undecoded response data, exposed as info_json.
+func (s *serverInfoAPIResponse) toModel(raw json.RawMessage) (*ServerInfoDataSourceModel, error) {
+	model := &ServerInfoDataSourceModel{
+		Version:         stringOrNull(s.Version),
+		Project:         types.ObjectNull(serverProjectAttrTypes),
+		QueryLimit:      types.ObjectNull(serverQueryLimitAttrTypes),
+		ExtensionsLimit: types.Int64Null(),
+		InfoJSON:        jsonStringValue(types.StringNull(), raw),
+	}
+
+	if s.Project != nil {
+		model.Project = types.ObjectValueMust(serverProjectAttrTypes, map[string]attr.Value{
+			"name":                stringOrNull(s.Project.ProjectName),
+			"descriptor":          stringOrNull(s.Project.ProjectDescriptor),
+			"logo":                stringOrNull(s.Project.ProjectLogo),
+			"color":               stringOrNull(s.Project.ProjectColor),
+			"default_language":    stringOrNull(s.Project.DefaultLanguage),
+			"public_note":         stringOrNull(s.Project.PublicNote),
+			"public_registration": types.BoolValue(s.Project.PublicRegistration),
+		})
+	}
+
+	var err error
+	if model.RateLimit, err = rateLimitValue(s.RateLimit); err != nil {
+		return nil, fmt.Errorf("rateLimit: %w", err)
+	}
+	if model.RateLimitGlobal, err = rateLimitValue(s.RateLimitGlobal); err != nil {
+		return nil, fmt.Errorf("rateLimitGlobal: %w", err)
+	}
+
+	if s.QueryLimit != nil {
+		model.QueryLimit = types.ObjectValueMust(serverQueryLimitAttrTypes, map[string]attr.Value{
+			"default": int64OrNull(s.QueryLimit.Default),
+			"max":     int64OrNull(s.QueryLimit.Max),
+		})
+	}
+	if s.Extensions != nil {
+		model.ExtensionsLimit = int64OrNull(s.Extensions.Limit)
+	}
+
+	if model.Websocket, err = websocketValue(s.Websocket); err != nil {
+		return nil, fmt.Errorf("websocket: %w", err)
+	}
+	if model.Cache, err = cacheValue(s.Cache); err != nil {
+		return nil, fmt.Errorf("cache: %w", err)
+	}
+
+	return model, nil
+}
+
+// rateLimitValue converts a rateLimit or rateLimitGlobal setting to an object value.
+func rateLimitValue(raw json.RawMessage) (types.Object, error) {
+	var limit serverRateLimitResponse
+	present, enabled, err := decodeToggle(raw, &limit)
+	if err != nil || !present {
+		return types.ObjectNull(serverRateLimitAttrTypes), err
+	}
+	return types.ObjectValueMust(serverRateLimitAttrTypes, map[string]attr.Value{
+		"enabled":  types.BoolValue(enabled),
+		"points":   int64OrNull(limit.Points),
+		"duration": int64OrNull(limit.Duration),
+	}), nil
+}
+
+// websocketValue converts the websocket setting to an object value. The REST and GraphQL
+// endpoints and the heartbeat can each be disabled with false.
+func websocketValue(raw json.RawMessage) (types.Object, error) {
+	var websocket serverWebsocketResponse
+	present, enabled, err := decodeToggle(raw, &websocket)
+	if err != nil || !present {
+		return types.ObjectNull(serverWebsocketAttrTypes), err
+	}
+
+	var rest, graphql serverWebsocketEndpoint
+	if _, _, err := decodeToggle(websocket.REST, &rest); err != nil {
+		return types.ObjectNull(serverWebsocketAttrTypes), err
+	}
+	if _, _, err := decodeToggle(websocket.GraphQL, &graphql); err != nil {
+		return types.ObjectNull(serverWebsocketAttrTypes), err
+	}
+	var heartbeat *int64
+	if _, _, err := decodeToggle(websocket.Heartbeat, &heartbeat); err != nil {
+		return types.ObjectNull(serverWebsocketAttrTypes), err
+	}
+
+	return types.ObjectValueMust(serverWebsocketAttrTypes, map[string]attr.Value{
+		"enabled":      types.BoolValue(enabled),
+		"rest_path":    stringOrNull(rest.Path),
+		"graphql_path": stringOrNull(graphql.Path),
+		"heartbeat":    int64OrNull(heartbeat),
+	}), nil
+}
+
+// cacheValue converts the cache setting to an object value.
+func cacheValue(raw json.RawMessage) (types.Object, error) {
+	var cache serverCacheResponse
+	present, enabled, err := decodeToggle(raw, &cache)
+	if err != nil || !present {
+		return types.ObjectNull(serverCacheAttrTypes), err
+	}
+	return types.ObjectValueMust(serverCacheAttrTypes, map[string]attr.Value{
+		"enabled": types.BoolValue(enabled),
+		"store":   stringOrNull(cache.Store),
+	}), nil
+}
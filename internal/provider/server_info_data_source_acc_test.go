@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerInfoDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "directus_server_info" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.directus_server_info.test", "version", regexp.MustCompile(`^\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttrSet("data.directus_server_info.test", "project.name"),
+					resource.TestCheckResourceAttrSet("data.directus_server_info.test", "info_json"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readServerInfoDataSource runs Read against the given /server/info data.
+func readServerInfoDataSource(t *testing.T, data map[string]interface{}) (*fwdatasource.ReadResponse, ServerInfoDataSourceModel) {
+	t.Helper()
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/server/info", req.URL.Path)
+		return mockJSONResponse(200, map[string]interface{}{"data": data}), nil
+	})
+
+	d := &ServerInfoDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{}, resp)
+
+	var result ServerInfoDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.State.Get(context.Background(), &result)
+	}
+	return resp, result
+}
+
+func TestServerInfoDataSource_Metadata(t *testing.T) {
+	d := &ServerInfoDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_server_info", resp.TypeName)
+}
+
+func TestServerInfoDataSource_Read_Admin(t *testing.T) {
+	resp, result := readServerInfoDataSource(t, map[string]interface{}{
+		"version": "11.1.0",
+		"project": map[string]interface{}{
+			"project_name":        "Acme CMS",
+			"project_color":       "#6644FF",
+			"project_logo":        nil,
+			"default_language":    "en-US",
+			"public_registration": false,
+		},
+		"rateLimit":       map[string]interface{}{"points": 50, "duration": 1},
+		"rateLimitGlobal": false,
+		"queryLimit":      map[string]interface{}{"default": 100, "max": -1},
+		"extensions":      map[string]interface{}{"limit": nil},
+		"websocket": map[string]interface{}{
+			"rest":      map[string]interface{}{"authentication": "handshake", "path": "/websocket"},
+			"graphql":   false,
+			"heartbeat": 15,
+		},
+	})
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, "11.1.0", result.Version.ValueString())
+
+	project := result.Project.Attributes()
+	assert.Equal(t, `"Acme CMS"`, project["name"].String())
+	assert.True(t, project["logo"].IsNull())
+
+	rateLimit := result.RateLimit.Attributes()
+	assert.Equal(t, "true", rateLimit["enabled"].String())
+	assert.Equal(t, "50", rateLimit["points"].String())
+
+	rateLimitGlobal := result.RateLimitGlobal.Attributes()
+	assert.Equal(t, "false", rateLimitGlobal["enabled"].String())
+	assert.True(t, rateLimitGlobal["points"].IsNull())
+
+	assert.Equal(t, "-1", result.QueryLimit.Attributes()["max"].String())
+	assert.True(t, result.ExtensionsLimit.IsNull())
+
+	websocket := result.Websocket.Attributes()
+	assert.Equal(t, "true", websocket["enabled"].String())
+	assert.Equal(t, `"/websocket"`, websocket["rest_path"].String())
+	assert.True(t, websocket["graphql_path"].IsNull())
+	assert.Equal(t, "15", websocket["heartbeat"].String())
+
+	// Directus 11 does not report cache settings.
+	assert.True(t, result.Cache.IsNull())
+
+	assert.Contains(t, result.InfoJSON.ValueString(), `"rateLimitGlobal":false`)
+}
+
+func TestServerInfoDataSource_Read_Public(t *testing.T) {
+	resp, result := readServerInfoDataSource(t, map[string]interface{}{
+		"project": map[string]interface{}{"project_name": "Acme CMS"},
+	})
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	assert.True(t, result.Version.IsNull())
+	assert.False(t, result.Project.IsNull())
+	assert.True(t, result.RateLimit.IsNull())
+	assert.True(t, result.RateLimitGlobal.IsNull())
+	assert.True(t, result.QueryLimit.IsNull())
+	assert.True(t, result.Websocket.IsNull())
+	assert.True(t, result.Cache.IsNull())
+}
+
+func TestServerInfoDataSource_Read_WebsocketDisabled(t *testing.T) {
+	resp, result := readServerInfoDataSource(t, map[string]interface{}{
+		"websocket":  false,
+		"extensions": map[string]interface{}{"limit": 5},
+	})
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	websocket := result.Websocket.Attributes()
+	assert.Equal(t, "false", websocket["enabled"].String())
+	assert.True(t, websocket["rest_path"].IsNull())
+	assert.True(t, websocket["heartbeat"].IsNull())
+	assert.Equal(t, int64(5), result.ExtensionsLimit.ValueInt64())
+}
+
+func TestServerInfoDataSource_Read_Cache(t *testing.T) {
+	resp, result := readServerInfoDataSource(t, map[string]interface{}{
+		"cache": map[string]interface{}{"store": "redis"},
+	})
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	cache := result.Cache.Attributes()
+	assert.Equal(t, "true", cache["enabled"].String())
+	assert.Equal(t, `"redis"`, cache["store"].String())
+
+	resp, result = readServerInfoDataSource(t, map[string]interface{}{"cache": false})
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	cache = result.Cache.Attributes()
+	assert.Equal(t, "false", cache["enabled"].String())
+	assert.True(t, cache["store"].IsNull())
+}
+
+func TestServerInfoDataSource_Read_Error(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return mockErrorResponse(403, "You don't have permission to access this."), nil
+	})
+
+	d := &ServerInfoDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Error Reading Server Info", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+// settingsID is the fixed ID of the settings singleton in Terraform state and on import.
+const settingsID = "settings"
+
+var (
+	_ resource.Resource                   = &SettingsResource{}
+	_ resource.ResourceWithConfigure      = &SettingsResource{}
+	_ resource.ResourceWithImportState    = &SettingsResource{}
+	_ resource.ResourceWithValidateConfig = &SettingsResource{}
+)
+
+// NewSettingsResource creates a new settings resource.
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+// SettingsResource defines the resource implementation.
+type SettingsResource struct {
+	client *client.Client
+}
+
+// SettingsResourceModel describes the resource data model.
+type SettingsResourceModel struct {
+	ID                            types.String `tfsdk:"id"`
+	ProjectName                   types.String `tfsdk:"project_name"`
+	ProjectDescriptor             types.String `tfsdk:"project_descriptor"`
+	ProjectURL                    types.String `tfsdk:"project_url"`
+	ProjectColor                  types.String `tfsdk:"project_color"`
+	DefaultLanguage               types.String `tfsdk:"default_language"`
+	PublicRegistration            types.Bool   `tfsdk:"public_registration"`
+	PublicRegistrationVerifyEmail types.Bool   `tfsdk:"public_registration_verify_email"`
+	PublicRegistrationRole        types.String `tfsdk:"public_registration_role"`
+	AuthPasswordPolicy            types.String `tfsdk:"auth_password_policy"`
+	AuthLoginAttempts             types.Int64  `tfsdk:"auth_login_attempts"`
+	ModuleBar                     types.String `tfsdk:"module_bar"`
+	StorageDefaultFolder          types.String `tfsdk:"storage_default_folder"`
+	StorageAssetTransform         types.String `tfsdk:"storage_asset_transform"`
+	StorageAssetPresets           types.String `tfsdk:"storage_asset_presets"`
+}
+
+func (r *SettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_settings"
+}
+
+// managedString returns the schema of a settings attribute that is only managed when configured.
+func managedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *SettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Settings resource. Manages the project-wide settings singleton. " +
+			"Only configured attributes are managed; attributes left out keep their current value in Directus and are reported as computed. " +
+			"Destroying the resource leaves the settings untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Fixed identifier of the settings singleton (`settings`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_name":       managedString("The name of the project, shown in the App and in emails."),
+			"project_descriptor": managedString("A short description shown below the project name on the login page."),
+			"project_url":        managedString("The public URL of the project, linked from the App."),
+			"project_color":      managedString("The brand color of the project as a hex code (e.g., #6644FF)."),
+			"default_language":   managedString("The default language of the App (e.g., `en-US`)."),
+			"public_registration": schema.BoolAttribute{
+				MarkdownDescription: "Whether users can register themselves from the login page.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_registration_verify_email": schema.BoolAttribute{
+				MarkdownDescription: "Whether self-registered users must verify their email address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_registration_role": managedString("The UUID of the role assigned to self-registered users."),
+			"auth_password_policy": managedString("Regular expression that user passwords must match. " +
+				"Directus offers `/^.{8,}$/` (weak) and `/(?=^.{8,}$)(?=.*\\d)(?=.*[a-z])(?=.*[A-Z])(?=.*[!@#$%^&*()_+}{';'?>.<,])(?!.*\\s).*$/` (strong)."),
+			"auth_login_attempts": schema.Int64Attribute{
+				MarkdownDescription: "Number of failed login attempts after which a user is suspended.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"module_bar":              managedString("JSON array describing the modules and links shown in the App's module bar."),
+			"storage_default_folder":  managedString("The UUID of the folder that new uploads are placed in by default."),
+			"storage_asset_transform": managedString("Which asset transformations are allowed: `all`, `none` or `presets`."),
+			"storage_asset_presets":   managedString("JSON array of asset transformation presets."),
+		},
+	}
+}
+
+// ValidateConfig checks the enumerated attributes and the JSON attributes.
+func (r *SettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateStringOneOf(data.StorageAssetTransform, "all", "none", "presets"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("storage_asset_transform"), "Invalid Storage Asset Transform", err.Error())
+	}
+	if err := validateJSONString(data.ModuleBar); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("module_bar"), "Invalid Module Bar", err.Error())
+	}
+	if err := validateJSONString(data.StorageAssetPresets); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("storage_asset_presets"), "Invalid Storage Asset Presets", err.Error())
+	}
+	if !data.AuthLoginAttempts.IsNull() && !data.AuthLoginAttempts.IsUnknown() && data.AuthLoginAttempts.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_login_attempts"),
+			"Invalid Login Attempts",
+			fmt.Sprintf("auth_login_attempts must be at least 1, got: %d", data.AuthLoginAttempts.ValueInt64()),
+		)
+	}
+}
+
+func (r *SettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create adopts the existing settings singleton and applies the configured attributes.
+func (r *SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.apply(ctx, plan, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Settings",
+			"Could not update settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data settingsAPIResponse `json:"data"`
+	}
+
+	if err := r.client.GetSingleton(ctx, "settings", &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Settings",
+			"Could not read settings: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(state))...)
+}
+
+func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.apply(ctx, plan, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Settings",
+			"Could not update settings: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete only removes the settings from Terraform state. The settings singleton
+// cannot be deleted, and resetting it would discard values Terraform never managed.
+func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState accepts only the fixed ID "settings".
+func (r *SettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != settingsID {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Settings are a singleton and must be imported with the ID %q, got: %q", settingsID, req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured attributes to Directus and returns the resulting state.
+// When nothing is configured the current settings are read instead, since Directus
+// rejects empty updates.
+func (r *SettingsResource) apply(ctx context.Context, plan, config SettingsResourceModel) (*SettingsResourceModel, error) {
+	var response struct {
+		Data settingsAPIResponse `json:"data"`
+	}
+
+	input := buildSettingsInput(config)
+
+	var err error
+	if len(input) == 0 {
+		err = r.client.GetSingleton(ctx, "settings", &response)
+	} else {
+		err = r.client.UpdateSingleton(ctx, "settings", input, &response)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.toModel(plan), nil
+}
+
+// settingsAPIResponse represents the API response for settings operations.
+type settingsAPIResponse struct {
+	ProjectName                   string          `json:"project_name,omitempty"`
+	ProjectDescriptor             string          `json:"project_descriptor,omitempty"`
+	ProjectURL                    string          `json:"project_url,omitempty"`
+	ProjectColor                  string          `json:"project_color,omitempty"`
+	DefaultLanguage               string          `json:"default_language,omitempty"`
+	PublicRegistration            bool            `json:"public_registration"`
+	PublicRegistrationVerifyEmail bool            `json:"public_registration_verify_email"`
+	PublicRegistrationRole        string          `json:"public_registration_role,omitempty"`
+	AuthPasswordPolicy            string          `json:"auth_password_policy,omitempty"`
+	AuthLoginAttempts             *int64          `json:"auth_login_attempts,omitempty"`
+	ModuleBar                     json.RawMessage `json:"module_bar,omitempty"`
+	StorageDefaultFolder          string          `json:"storage_default_folder,omitempty"`
+	StorageAssetTransform         string          `json:"storage_asset_transform,omitempty"`
+	StorageAssetPresets           json.RawMessage `json:"storage_asset_presets,omitempty"`
+}
+
+// toModel converts settingsAPIResponse to SettingsResourceModel, keeping the prior
+// JSON strings when they are semantically equal to the API values.
+func (s *settingsAPIResponse) toModel(prior SettingsResourceModel) *SettingsResourceModel {
+	return &SettingsResourceModel{
+		ID:                            types.StringValue(settingsID),
+		ProjectName:                   stringOrNull(s.ProjectName),
+		ProjectDescriptor:             stringOrNull(s.ProjectDescriptor),
+		ProjectURL:                    stringOrNull(s.ProjectURL),
+		ProjectColor:                  stringOrNull(s.ProjectColor),
+		DefaultLanguage:               stringOrNull(s.DefaultLanguage),
+		PublicRegistration:            types.BoolValue(s.PublicRegistration),
+		PublicRegistrationVerifyEmail: types.BoolValue(s.PublicRegistrationVerifyEmail),
+		PublicRegistrationRole:        stringOrNull(s.PublicRegistrationRole),
+		AuthPasswordPolicy:            stringOrNull(s.AuthPasswordPolicy),
+		AuthLoginAttempts:             types.Int64PointerValue(s.AuthLoginAttempts),
+		ModuleBar:                     jsonStringValue(prior.ModuleBar, s.ModuleBar),
+		StorageDefaultFolder:          stringOrNull(s.StorageDefaultFolder),
+		StorageAssetTransform:         stringOrNull(s.StorageAssetTransform),
+		StorageAssetPresets:           jsonStringValue(prior.StorageAssetPresets, s.StorageAssetPresets),
+	}
+}
+
+// buildSettingsInput constructs the update payload from the configuration.
+// Only attributes set in the configuration are sent, so settings managed
+// elsewhere (e.g. in the App) are left untouched.
+func buildSettingsInput(config SettingsResourceModel) map[string]interface{} {
+	input := map[string]interface{}{}
+
+	setStringField(input, "project_name", config.ProjectName)
+	setStringField(input, "project_descriptor", config.ProjectDescriptor)
+	setStringField(input, "project_url", config.ProjectURL)
+	setStringField(input, "project_color", config.ProjectColor)
+	setStringField(input, "default_language", config.DefaultLanguage)
+	setBoolField(input, "public_registration", config.PublicRegistration)
+	setBoolField(input, "public_registration_verify_email", config.PublicRegistrationVerifyEmail)
+	setStringField(input, "public_registration_role", config.PublicRegistrationRole)
+	setStringField(input, "auth_password_policy", config.AuthPasswordPolicy)
+	setInt64Field(input, "auth_login_attempts", config.AuthLoginAttempts)
+	setJSONField(input, "module_bar", config.ModuleBar)
+	setStringField(input, "storage_default_folder", config.StorageDefaultFolder)
+	setStringField(input, "storage_asset_transform", config.StorageAssetTransform)
+	setJSONField(input, "storage_asset_presets", config.StorageAssetPresets)
+
+	return input
+}
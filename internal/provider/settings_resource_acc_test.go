@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Settings cannot be destroyed, so no CheckDestroy is registered. The test only
+// touches attributes that are safe to leave changed on the test instance.
+func TestAccSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_settings" "test" {
+  project_descriptor = "AccTest"
+  project_color      = "#6644FF"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_settings.test", "id", "settings"),
+					resource.TestCheckResourceAttr("directus_settings.test", "project_descriptor", "AccTest"),
+					resource.TestCheckResourceAttrSet("directus_settings.test", "project_name"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_settings" "test" {
+  project_descriptor  = "AccTest Updated"
+  project_color       = "#2ECDA7"
+  auth_login_attempts = 50
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_settings.test", "project_descriptor", "AccTest Updated"),
+					resource.TestCheckResourceAttr("directus_settings.test", "auth_login_attempts", "50"),
+				),
+			},
+			{
+				ResourceName:      "directus_settings.test",
+				ImportState:       true,
+				ImportStateId:     "settings",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
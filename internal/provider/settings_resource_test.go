@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestSettingsResourceSchema(t *testing.T) {
+	r := &SettingsResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for name, attr := range schemaResp.Schema.Attributes {
+		assert.True(t, attr.IsComputed(), "%s should be computed", name)
+		assert.False(t, attr.IsRequired(), "%s should not be required", name)
+	}
+	for _, attr := range []string{"project_name", "project_color", "public_registration", "auth_password_policy", "auth_login_attempts", "module_bar", "default_language", "storage_default_folder"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+}
+
+func TestSettingsResourceMetadata(t *testing.T) {
+	r := &SettingsResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_settings", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+// newSettingsModel returns a model with every attribute unset.
+func newSettingsModel() *SettingsResourceModel {
+	return &SettingsResourceModel{
+		ID:                            types.StringNull(),
+		ProjectName:                   types.StringNull(),
+		ProjectDescriptor:             types.StringNull(),
+		ProjectURL:                    types.StringNull(),
+		ProjectColor:                  types.StringNull(),
+		DefaultLanguage:               types.StringNull(),
+		PublicRegistration:            types.BoolNull(),
+		PublicRegistrationVerifyEmail: types.BoolNull(),
+		PublicRegistrationRole:        types.StringNull(),
+		AuthPasswordPolicy:            types.StringNull(),
+		AuthLoginAttempts:             types.Int64Null(),
+		ModuleBar:                     types.StringNull(),
+		StorageDefaultFolder:          types.StringNull(),
+		StorageAssetTransform:         types.StringNull(),
+		StorageAssetPresets:           types.StringNull(),
+	}
+}
+
+func TestSettingsResource_ValidateConfig(t *testing.T) {
+	r := &SettingsResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *SettingsResourceModel)
+		expectErr bool
+	}{
+		{"empty", func(m *SettingsResourceModel) {}, false},
+		{"valid", func(m *SettingsResourceModel) {
+			m.StorageAssetTransform = types.StringValue("presets")
+			m.ModuleBar = types.StringValue(`[{"type":"module","id":"content","enabled":true}]`)
+			m.AuthLoginAttempts = types.Int64Value(25)
+		}, false},
+		{"invalid asset transform", func(m *SettingsResourceModel) { m.StorageAssetTransform = types.StringValue("some") }, true},
+		{"invalid module bar", func(m *SettingsResourceModel) { m.ModuleBar = types.StringValue("[") }, true},
+		{"invalid asset presets", func(m *SettingsResourceModel) { m.StorageAssetPresets = types.StringValue("{") }, true},
+		{"zero login attempts", func(m *SettingsResourceModel) { m.AuthLoginAttempts = types.Int64Value(0) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newSettingsModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildSettingsInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildSettingsInput(t *testing.T) {
+	t.Run("only configured attributes", func(t *testing.T) {
+		config := newSettingsModel()
+		config.ProjectName = types.StringValue("Acme")
+		config.PublicRegistration = types.BoolValue(false)
+		config.AuthLoginAttempts = types.Int64Value(10)
+		config.ModuleBar = types.StringValue(`[{"type":"module","id":"content","enabled":true}]`)
+
+		input := buildSettingsInput(*config)
+
+		assert.Equal(t, map[string]interface{}{
+			"project_name":        "Acme",
+			"public_registration": false,
+			"auth_login_attempts": int64(10),
+			"module_bar": []interface{}{
+				map[string]interface{}{"type": "module", "id": "content", "enabled": true},
+			},
+		}, input)
+	})
+
+	t.Run("nothing configured", func(t *testing.T) {
+		assert.Empty(t, buildSettingsInput(*newSettingsModel()))
+	})
+}
+
+func TestSettingsAPIResponseToModel(t *testing.T) {
+	prior := newSettingsModel()
+	prior.ModuleBar = types.StringValue(`[ {"id": "content", "type": "module", "enabled": true} ]`)
+
+	attempts := int64(25)
+	model := (&settingsAPIResponse{
+		ProjectName:        "Acme",
+		ProjectColor:       "#6644FF",
+		PublicRegistration: true,
+		AuthLoginAttempts:  &attempts,
+		ModuleBar:          json.RawMessage(`[{"type":"module","id":"content","enabled":true}]`),
+	}).toModel(*prior)
+
+	assert.Equal(t, settingsID, model.ID.ValueString())
+	assert.Equal(t, "Acme", model.ProjectName.ValueString())
+	assert.True(t, model.PublicRegistration.ValueBool())
+	assert.False(t, model.PublicRegistrationVerifyEmail.ValueBool())
+	assert.Equal(t, int64(25), model.AuthLoginAttempts.ValueInt64())
+	assert.True(t, model.ProjectURL.IsNull())
+	assert.Equal(t, prior.ModuleBar.ValueString(), model.ModuleBar.ValueString(), "semantically equal module bar should keep the prior value")
+	assert.True(t, model.StorageAssetPresets.IsNull())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func settingsResponse(projectName string) map[string]interface{} {
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"id": 1, "project_name": projectName, "project_color": "#6644FF",
+			"public_registration": false, "public_registration_verify_email": true,
+			"auth_login_attempts": 25, "module_bar": nil, "storage_asset_transform": "all",
+		},
+	}
+}
+
+func TestSettingsResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/settings", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, map[string]interface{}{"project_name": "Acme"}, body, "only configured attributes should be sent")
+
+		return mockJSONResponse(200, settingsResponse("Acme")), nil
+	})
+
+	r := &SettingsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	config := newSettingsModel()
+	config.ProjectName = types.StringValue("Acme")
+
+	plan := newSettingsModel()
+	plan.ID = types.StringUnknown()
+	plan.ProjectName = types.StringValue("Acme")
+	plan.ProjectColor = types.StringUnknown()
+	plan.AuthLoginAttempts = types.Int64Unknown()
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{
+		Plan:   makePlan(t, schema, plan),
+		Config: makeConfig(t, schema, config),
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result SettingsResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "settings", result.ID.ValueString())
+	assert.Equal(t, "Acme", result.ProjectName.ValueString())
+	assert.Equal(t, "#6644FF", result.ProjectColor.ValueString())
+	assert.Equal(t, int64(25), result.AuthLoginAttempts.ValueInt64())
+	assert.True(t, result.PublicRegistrationVerifyEmail.ValueBool())
+}
+
+func TestSettingsResource_CreateWithoutAttributes(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method, "an empty configuration should only read the settings")
+		assert.Equal(t, "http://example.com/settings", req.URL.String())
+		return mockJSONResponse(200, settingsResponse("Directus")), nil
+	})
+
+	r := &SettingsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{
+		Plan:   makePlan(t, schema, newSettingsModel()),
+		Config: makeConfig(t, schema, newSettingsModel()),
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result SettingsResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "Directus", result.ProjectName.ValueString())
+}
+
+func TestSettingsResource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "http://example.com/settings", req.URL.String())
+		return mockJSONResponse(200, settingsResponse("Changed in App")), nil
+	})
+
+	r := &SettingsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newSettingsModel()
+	model.ID = types.StringValue(settingsID)
+	model.ProjectName = types.StringValue("Acme")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result SettingsResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "Changed in App", result.ProjectName.ValueString())
+}
+
+func TestSettingsResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("Delete should not call the API, got %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &SettingsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newSettingsModel()
+	model.ID = types.StringValue(settingsID)
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
+
+// ---------------------------------------------------------------------------
+// ImportState
+// ---------------------------------------------------------------------------
+
+func TestSettingsResource_ImportState(t *testing.T) {
+	r := &SettingsResource{}
+	schema := getResourceSchema(t, r)
+
+	t.Run("fixed id", func(t *testing.T) {
+		resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "settings"}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "ImportState diagnostics: %v", resp.Diagnostics)
+
+		var id types.String
+		resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+		assert.Equal(t, "settings", id.ValueString())
+	})
+
+	t.Run("other id", func(t *testing.T) {
+		resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "1"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &ShareResource{}
+	_ resource.ResourceWithConfigure      = &ShareResource{}
+	_ resource.ResourceWithImportState    = &ShareResource{}
+	_ resource.ResourceWithValidateConfig = &ShareResource{}
+)
+
+// NewShareResource creates a new share resource.
+func NewShareResource() resource.Resource {
+	return &ShareResource{}
+}
+
+// ShareResource defines the resource implementation.
+type ShareResource struct {
+	client *client.Client
+}
+
+// ShareResourceModel describes the resource data model.
+type ShareResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Collection        types.String `tfsdk:"collection"`
+	Item              types.String `tfsdk:"item"`
+	Role              types.String `tfsdk:"role"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	DateStart         types.String `tfsdk:"date_start"`
+	DateEnd           types.String `tfsdk:"date_end"`
+	MaxUses           types.Int64  `tfsdk:"max_uses"`
+	TimesUsed         types.Int64  `tfsdk:"times_used"`
+	URL               types.String `tfsdk:"url"`
+}
+
+func (r *ShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_share"
+}
+
+func (r *ShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Share resource. Shares give read-only access to a single item through a link, " +
+			"optionally protected by a password and limited in time or number of uses.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the share (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A name for the share, shown in the sidebar of the shared item.",
+				Optional:            true,
+			},
+			"collection": schema.StringAttribute{
+				MarkdownDescription: "The collection of the shared item. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item": schema.StringAttribute{
+				MarkdownDescription: "The primary key of the shared item. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the role whose policies scope what the share can access. " +
+					"When omitted, the permissions of the user creating the share apply.",
+				Optional: true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password required to open the share. Write-only: it is never stored in state. " +
+					"Change `password_wo_version` to update it. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`. The password is sent to Directus on create and whenever this value changes.",
+				Optional:            true,
+			},
+			"date_start": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp from which the share can be used.",
+				Optional:            true,
+			},
+			"date_end": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp after which the share expires.",
+				Optional:            true,
+			},
+			"max_uses": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times the share can be opened.",
+				Optional:            true,
+			},
+			"times_used": schema.Int64Attribute{
+				MarkdownDescription: "The number of times the share has been opened.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The share link, derived from the provider endpoint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the timestamps and the usage limit.
+func (r *ShareResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ShareResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, startErr := parseTimestamp(data.DateStart)
+	if startErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("date_start"), "Invalid Share Start Date", startErr.Error())
+	}
+	end, endErr := parseTimestamp(data.DateEnd)
+	if endErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("date_end"), "Invalid Share End Date", endErr.Error())
+	}
+	if start != nil && end != nil && !end.After(*start) {
+		resp.Diagnostics.AddAttributeError(path.Root("date_end"), "Invalid Share End Date", "date_end must be after date_start.")
+	}
+
+	if !data.MaxUses.IsNull() && !data.MaxUses.IsUnknown() && data.MaxUses.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_uses"),
+			"Invalid Share Max Uses",
+			fmt.Sprintf("max_uses must be at least 1, got: %d", data.MaxUses.ValueInt64()),
+		)
+	}
+}
+
+func (r *ShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config ShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := buildShareInput(plan, true)
+	setStringField(input, "password", config.PasswordWO)
+
+	var response struct {
+		Data shareAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "shares", input, &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Share",
+			"Could not create share, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan, r.client.BaseURL))...)
+}
+
+func (r *ShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ShareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data shareAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "shares", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Share",
+			"Could not read share ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(state, r.client.BaseURL))...)
+}
+
+func (r *ShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config ShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := buildShareInput(plan, false)
+	// The password is write-only, so it is only sent when its version changes.
+	if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		setNullableStringField(input, "password", config.PasswordWO)
+	}
+
+	var response struct {
+		Data shareAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "shares", plan.ID.ValueString(), input, &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Share",
+			"Could not update share ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel(plan, r.client.BaseURL))...)
+}
+
+func (r *ShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ShareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "shares", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Share",
+			"Could not delete share ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// shareAPIResponse represents the API response for share operations.
+// The password is never decoded; Directus only returns a masked value.
+type shareAPIResponse struct {
+	ID         string        `json:"id"`
+	Name       string        `json:"name,omitempty"`
+	Collection string        `json:"collection"`
+	Item       string        `json:"item"`
+	Role       string        `json:"role,omitempty"`
+	DateStart  string        `json:"date_start,omitempty"`
+	DateEnd    string        `json:"date_end,omitempty"`
+	MaxUses    *int64        `json:"max_uses,omitempty"`
+	TimesUsed  flexibleInt64 `json:"times_used,omitempty"`
+}
+
+// toModel converts shareAPIResponse to ShareResourceModel. The password version is carried
+// over from prior, and timestamps keep their configured format when they denote the same instant.
+func (s *shareAPIResponse) toModel(prior ShareResourceModel, endpoint string) *ShareResourceModel {
+	model := &ShareResourceModel{
+		ID:                types.StringValue(s.ID),
+		Name:              stringOrNull(s.Name),
+		Collection:        types.StringValue(s.Collection),
+		Item:              types.StringValue(s.Item),
+		Role:              stringOrNull(s.Role),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: prior.PasswordWOVersion,
+		DateStart:         timestampValue(prior.DateStart, s.DateStart),
+		DateEnd:           timestampValue(prior.DateEnd, s.DateEnd),
+		MaxUses:           types.Int64Null(),
+		TimesUsed:         types.Int64Value(int64(s.TimesUsed)),
+		URL:               types.StringValue(shareURL(endpoint, s.ID)),
+	}
+	if s.MaxUses != nil {
+		model.MaxUses = types.Int64Value(*s.MaxUses)
+	}
+	return model
+}
+
+// shareURL returns the Data Studio link that opens a share.
+func shareURL(endpoint, id string) string {
+	return strings.TrimRight(endpoint, "/") + "/admin/shared/" + id
+}
+
+// buildShareInput constructs the input from the resource model (used for both create and update).
+// The write-only password is added by the caller.
+func buildShareInput(data ShareResourceModel, isCreate bool) map[string]interface{} {
+	input := map[string]interface{}{}
+
+	if isCreate {
+		input["collection"] = data.Collection.ValueString()
+		input["item"] = data.Item.ValueString()
+		setStringField(input, "name", data.Name)
+		setStringField(input, "role", data.Role)
+		setStringField(input, "date_start", data.DateStart)
+		setStringField(input, "date_end", data.DateEnd)
+		setInt64Field(input, "max_uses", data.MaxUses)
+	} else {
+		setNullableStringField(input, "name", data.Name)
+		setNullableStringField(input, "role", data.Role)
+		setNullableStringField(input, "date_start", data.DateStart)
+		setNullableStringField(input, "date_end", data.DateEnd)
+		if data.MaxUses.IsNull() {
+			input["max_uses"] = nil
+		} else {
+			setInt64Field(input, "max_uses", data.MaxUses)
+		}
+	}
+
+	return input
+}
+
+// parseTimestamp parses an RFC 3339 timestamp attribute. Null and unknown values yield nil.
+func parseTimestamp(value types.String) (*time.Time, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("value must be an RFC 3339 timestamp, e.g. 2025-01-31T18:00:00Z, got: %q", value.ValueString())
+	}
+	return &t, nil
+}
+
+// timestampValue converts a timestamp returned by the API into a types.String.
+// The prior value is kept when it denotes the same instant, since Directus
+// returns timestamps in its own format (e.g. with milliseconds).
+func timestampValue(prior types.String, raw string) types.String {
+	if raw == "" {
+		return types.StringNull()
+	}
+	if p, err := parseTimestamp(prior); err == nil && p != nil {
+		if t, err := time.Parse(time.RFC3339, raw); err == nil && t.Equal(*p) {
+			return prior
+		}
+	}
+	return types.StringValue(raw)
+}
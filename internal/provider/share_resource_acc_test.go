@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const testAccShareItemConfig = `
+resource "directus_collection" "test" {
+  collection = "acc_test_shared"
+}
+
+resource "directus_item" "test" {
+  collection = directus_collection.test.collection
+  data       = jsonencode({})
+}
+`
+
+func TestAccShare_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		// password_wo is a write-only attribute.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_share", "shares"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccShareItemConfig + `
+resource "directus_share" "test" {
+  name       = "AccTest Share"
+  collection = directus_collection.test.collection
+  item       = directus_item.test.primary_key
+  date_end   = "2099-01-01T00:00:00Z"
+  max_uses   = 10
+
+  password_wo         = "acctest-password"
+  password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_share.test", "id"),
+					resource.TestCheckResourceAttr("directus_share.test", "date_end", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("directus_share.test", "max_uses", "10"),
+					resource.TestCheckResourceAttr("directus_share.test", "times_used", "0"),
+					resource.TestCheckNoResourceAttr("directus_share.test", "password_wo"),
+					resource.TestMatchResourceAttr("directus_share.test", "url", regexp.MustCompile(`/admin/shared/[0-9a-f-]{36}$`)),
+				),
+			},
+			// Lift the usage limit and rotate the password
+			{
+				Config: testAccProviderConfig() + testAccShareItemConfig + `
+resource "directus_share" "test" {
+  name       = "AccTest Share"
+  collection = directus_collection.test.collection
+  item       = directus_item.test.primary_key
+  date_end   = "2099-01-01T00:00:00Z"
+
+  password_wo         = "acctest-password-2"
+  password_wo_version = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("directus_share.test", "max_uses"),
+					resource.TestCheckResourceAttr("directus_share.test", "password_wo_version", "2"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:            "directus_share.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_wo_version", "date_end"},
+			},
+		},
+	})
+}
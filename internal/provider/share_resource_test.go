@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestShareResourceSchema(t *testing.T) {
+	r := &ShareResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"id", "name", "collection", "item", "role", "password_wo", "password_wo_version", "date_start", "date_end", "max_uses", "times_used", "url"} {
+		assert.NotNil(t, schemaResp.Schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["password_wo"].IsWriteOnly())
+	assert.True(t, schemaResp.Schema.Attributes["password_wo"].IsSensitive())
+	assert.True(t, schemaResp.Schema.Attributes["url"].IsComputed())
+}
+
+func TestShareResourceMetadata(t *testing.T) {
+	r := &ShareResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_share", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func newShareModel() *ShareResourceModel {
+	return &ShareResourceModel{
+		ID:                types.StringNull(),
+		Name:              types.StringNull(),
+		Collection:        types.StringValue("articles"),
+		Item:              types.StringValue("42"),
+		Role:              types.StringNull(),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: types.Int64Null(),
+		DateStart:         types.StringNull(),
+		DateEnd:           types.StringNull(),
+		MaxUses:           types.Int64Null(),
+		TimesUsed:         types.Int64Null(),
+		URL:               types.StringNull(),
+	}
+}
+
+func TestShareResource_ValidateConfig(t *testing.T) {
+	r := &ShareResource{}
+	schema := getResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		modify    func(m *ShareResourceModel)
+		expectErr bool
+	}{
+		{"minimal", func(m *ShareResourceModel) {}, false},
+		{"time window", func(m *ShareResourceModel) {
+			m.DateStart = types.StringValue("2025-01-01T00:00:00Z")
+			m.DateEnd = types.StringValue("2025-02-01T00:00:00+01:00")
+		}, false},
+		{"end before start", func(m *ShareResourceModel) {
+			m.DateStart = types.StringValue("2025-02-01T00:00:00Z")
+			m.DateEnd = types.StringValue("2025-01-01T00:00:00Z")
+		}, true},
+		{"invalid start", func(m *ShareResourceModel) { m.DateStart = types.StringValue("2025-01-01") }, true},
+		{"invalid end", func(m *ShareResourceModel) { m.DateEnd = types.StringValue("tomorrow") }, true},
+		{"max uses", func(m *ShareResourceModel) { m.MaxUses = types.Int64Value(10) }, false},
+		{"zero max uses", func(m *ShareResourceModel) { m.MaxUses = types.Int64Value(0) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newShareModel()
+			tt.modify(model)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: makeConfig(t, schema, model)}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildShareInput / toModel
+// ---------------------------------------------------------------------------
+
+func TestBuildShareInput(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		model := newShareModel()
+		model.Name = types.StringValue("Press preview")
+		model.MaxUses = types.Int64Value(5)
+
+		input := buildShareInput(*model, true)
+
+		assert.Equal(t, "articles", input["collection"])
+		assert.Equal(t, "42", input["item"])
+		assert.Equal(t, "Press preview", input["name"])
+		assert.Equal(t, int64(5), input["max_uses"])
+		assert.NotContains(t, input, "role")
+		assert.NotContains(t, input, "password")
+	})
+
+	t.Run("update clears removed fields", func(t *testing.T) {
+		input := buildShareInput(*newShareModel(), false)
+
+		assert.NotContains(t, input, "collection")
+		assert.NotContains(t, input, "item")
+		for _, key := range []string{"name", "role", "date_start", "date_end", "max_uses"} {
+			assert.Contains(t, input, key)
+			assert.Nil(t, input[key], key)
+		}
+	})
+}
+
+func TestShareAPIResponseToModel(t *testing.T) {
+	prior := newShareModel()
+	prior.DateEnd = types.StringValue("2025-02-01T01:00:00+01:00")
+	prior.PasswordWOVersion = types.Int64Value(2)
+
+	maxUses := int64(5)
+	model := (&shareAPIResponse{
+		ID: "share-1", Collection: "articles", Item: "42", Role: "role-1",
+		DateEnd: "2025-02-01T00:00:00.000Z", MaxUses: &maxUses, TimesUsed: 3,
+	}).toModel(*prior, "https://cms.example.com/")
+
+	assert.Equal(t, "share-1", model.ID.ValueString())
+	assert.Equal(t, "role-1", model.Role.ValueString())
+	assert.Equal(t, prior.DateEnd.ValueString(), model.DateEnd.ValueString(), "same instant should keep the prior value")
+	assert.True(t, model.DateStart.IsNull())
+	assert.Equal(t, int64(5), model.MaxUses.ValueInt64())
+	assert.Equal(t, int64(3), model.TimesUsed.ValueInt64())
+	assert.Equal(t, int64(2), model.PasswordWOVersion.ValueInt64())
+	assert.True(t, model.PasswordWO.IsNull())
+	assert.Equal(t, "https://cms.example.com/admin/shared/share-1", model.URL.ValueString())
+}
+
+func TestTimestampValue(t *testing.T) {
+	assert.True(t, timestampValue(types.StringNull(), "").IsNull())
+	assert.Equal(t, "2025-01-01T00:00:00.000Z", timestampValue(types.StringNull(), "2025-01-01T00:00:00.000Z").ValueString())
+	assert.Equal(t, "2025-01-01T00:00:00Z", timestampValue(types.StringValue("2025-01-01T00:00:00Z"), "2025-01-01T00:00:00.000Z").ValueString())
+	assert.Equal(t, "2025-01-02T00:00:00.000Z", timestampValue(types.StringValue("2025-01-01T00:00:00Z"), "2025-01-02T00:00:00.000Z").ValueString())
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestShareResource_Create_SendsWriteOnlyPassword(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/shares", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, "articles", body["collection"])
+		assert.Equal(t, "s3cret", body["password"])
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "share-1", "collection": "articles", "item": "42", "password": "**********",
+				"times_used": 0, "max_uses": nil,
+			},
+		}), nil
+	})
+
+	r := &ShareResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := newShareModel()
+	plan.ID = types.StringUnknown()
+	plan.TimesUsed = types.Int64Unknown()
+	plan.URL = types.StringUnknown()
+	plan.PasswordWOVersion = types.Int64Value(1)
+
+	config := newShareModel()
+	config.PasswordWO = types.StringValue("s3cret")
+	config.PasswordWOVersion = types.Int64Value(1)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{
+		Plan:   makePlan(t, schema, plan),
+		Config: makeConfig(t, schema, config),
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result ShareResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "share-1", result.ID.ValueString())
+	assert.True(t, result.PasswordWO.IsNull(), "write-only password must not be stored")
+	assert.Equal(t, "http://example.com/admin/shared/share-1", result.URL.ValueString())
+}
+
+func TestShareResource_Update_Password(t *testing.T) {
+	tests := []struct {
+		name         string
+		stateVersion types.Int64
+		planVersion  types.Int64
+		wantPassword bool
+	}{
+		{"unchanged version", types.Int64Value(1), types.Int64Value(1), false},
+		{"bumped version", types.Int64Value(1), types.Int64Value(2), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "PATCH", req.Method)
+				assert.Equal(t, "http://example.com/shares/share-1", req.URL.String())
+
+				var body map[string]interface{}
+				bodyBytes, _ := io.ReadAll(req.Body)
+				json.Unmarshal(bodyBytes, &body)
+				if tt.wantPassword {
+					assert.Equal(t, "n3w", body["password"])
+				} else {
+					assert.NotContains(t, body, "password")
+				}
+
+				return mockJSONResponse(200, map[string]interface{}{
+					"data": map[string]interface{}{"id": "share-1", "collection": "articles", "item": "42", "times_used": 1},
+				}), nil
+			})
+
+			r := &ShareResource{client: mockClient}
+			schema := getResourceSchema(t, r)
+
+			state := newShareModel()
+			state.ID = types.StringValue("share-1")
+			state.PasswordWOVersion = tt.stateVersion
+
+			plan := newShareModel()
+			plan.ID = types.StringValue("share-1")
+			plan.PasswordWOVersion = tt.planVersion
+
+			config := newShareModel()
+			config.PasswordWO = types.StringValue("n3w")
+			config.PasswordWOVersion = tt.planVersion
+
+			resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+			r.Update(context.Background(), fwresource.UpdateRequest{
+				Plan:   makePlan(t, schema, plan),
+				State:  makeState(t, schema, state),
+				Config: makeConfig(t, schema, config),
+			}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestShareResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/shares/share-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &ShareResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	model := newShareModel()
+	model.ID = types.StringValue("share-1")
+	state := makeState(t, schema, model)
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                = &TranslationResource{}
+	_ resource.ResourceWithConfigure   = &TranslationResource{}
+	_ resource.ResourceWithImportState = &TranslationResource{}
+)
+
+// NewTranslationResource creates a new translation resource.
+func NewTranslationResource() resource.Resource {
+	return &TranslationResource{}
+}
+
+// TranslationResource defines the resource implementation.
+type TranslationResource struct {
+	client *client.Client
+}
+
+// TranslationResourceModel describes the resource data model.
+type TranslationResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Key      types.String `tfsdk:"key"`
+	Language types.String `tfsdk:"language"`
+	Value    types.String `tfsdk:"value"`
+}
+
+func (r *TranslationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_translation"
+}
+
+func (r *TranslationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Translation resource. Manages a single custom translation string, " +
+			"referenced in the Data Studio as `$t:<key>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the translation (UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The translation key, referenced as `$t:<key>`.",
+				Required:            true,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "The language code of the translation (e.g., `en-US`).",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The translated string.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *TranslationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *TranslationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TranslationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data translationAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Create(ctx, "translations", buildTranslationInput(plan), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Translation",
+			"Could not create translation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+func (r *TranslationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TranslationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data translationAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, "translations", state.ID.ValueString(), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Translation",
+			"Could not read translation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+func (r *TranslationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TranslationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response struct {
+		Data translationAPIResponse `json:"data"`
+	}
+
+	if err := r.client.Update(ctx, "translations", plan.ID.ValueString(), buildTranslationInput(plan), &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Translation",
+			"Could not update translation ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, response.Data.toModel())...)
+}
+
+func (r *TranslationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TranslationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, "translations", state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Translation",
+			"Could not delete translation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *TranslationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// translationAPIResponse represents the API response for translation operations.
+type translationAPIResponse struct {
+	ID       string `json:"id"`
+	Key      string `json:"key"`
+	Language string `json:"language"`
+	Value    string `json:"value"`
+}
+
+// toModel converts translationAPIResponse to TranslationResourceModel.
+func (t *translationAPIResponse) toModel() *TranslationResourceModel {
+	return &TranslationResourceModel{
+		ID:       types.StringValue(t.ID),
+		Key:      types.StringValue(t.Key),
+		Language: types.StringValue(t.Language),
+		Value:    types.StringValue(t.Value),
+	}
+}
+
+// buildTranslationInput constructs the input from the resource model (used for both create and update)
+func buildTranslationInput(data TranslationResourceModel) map[string]interface{} {
+	return map[string]interface{}{
+		"key":      data.Key.ValueString(),
+		"language": data.Language.ValueString(),
+		"value":    data.Value.ValueString(),
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The acceptance tests use made-up language codes so that the authoritative
+// directus_translations resource never touches real translations.
+
+func TestAccTranslation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroyed("directus_translation", "translations"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_translation" "test" {
+  key      = "acctest_greeting"
+  language = "zz-ZZ"
+  value    = "Hello"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("directus_translation.test", "id"),
+					resource.TestCheckResourceAttr("directus_translation.test", "value", "Hello"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_translation" "test" {
+  key      = "acctest_greeting"
+  language = "zz-ZZ"
+  value    = "Hello, world"
+}
+`,
+				Check: resource.TestCheckResourceAttr("directus_translation.test", "value", "Hello, world"),
+			},
+			{
+				ResourceName:      "directus_translation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslations_authoritative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_translations" "test" {
+  translations = {
+    "zz-ZZ" = {
+      acctest_title = "Title"
+      acctest_note  = "Note"
+    }
+    "zz-YY" = {
+      acctest_title = "Titel"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_translations.test", "id", "zz-YY,zz-ZZ"),
+					resource.TestCheckResourceAttr("directus_translations.test", "translations.zz-ZZ.acctest_note", "Note"),
+				),
+			},
+			// Remove a key and a language
+			{
+				Config: testAccProviderConfig() + `
+resource "directus_translations" "test" {
+  translations = {
+    "zz-ZZ" = {
+      acctest_title = "Updated title"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("directus_translations.test", "id", "zz-ZZ"),
+					resource.TestCheckResourceAttr("directus_translations.test", "translations.zz-ZZ.%", "1"),
+					resource.TestCheckResourceAttr("directus_translations.test", "translations.zz-ZZ.acctest_title", "Updated title"),
+				),
+			},
+			{
+				ResourceName:      "directus_translations.test",
+				ImportState:       true,
+				ImportStateId:     "zz-ZZ",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
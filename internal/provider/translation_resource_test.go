@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestTranslationResourceSchema(t *testing.T) {
+	r := &TranslationResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, attr := range []string{"key", "language", "value"} {
+		assert.True(t, schemaResp.Schema.Attributes[attr].IsRequired(), "%s should be required", attr)
+	}
+	assert.True(t, schemaResp.Schema.Attributes["id"].IsComputed())
+}
+
+func TestTranslationResourceMetadata(t *testing.T) {
+	r := &TranslationResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_translation", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestTranslationResource_Create(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "http://example.com/translations", req.URL.String())
+
+		var body map[string]interface{}
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &body)
+		assert.Equal(t, map[string]interface{}{"key": "field_note", "language": "en-US", "value": "Shown to editors"}, body)
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "tr-1", "key": "field_note", "language": "en-US", "value": "Shown to editors"},
+		}), nil
+	})
+
+	r := &TranslationResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &TranslationResourceModel{
+		ID:       types.StringUnknown(),
+		Key:      types.StringValue("field_note"),
+		Language: types.StringValue("en-US"),
+		Value:    types.StringValue("Shown to editors"),
+	})
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+
+	var result TranslationResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "tr-1", result.ID.ValueString())
+}
+
+func TestTranslationResource_Update(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "http://example.com/translations/tr-1", req.URL.String())
+
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{"id": "tr-1", "key": "field_note", "language": "en-US", "value": "Updated"},
+		}), nil
+	})
+
+	r := &TranslationResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &TranslationResourceModel{
+		ID:       types.StringValue("tr-1"),
+		Key:      types.StringValue("field_note"),
+		Language: types.StringValue("en-US"),
+		Value:    types.StringValue("Updated"),
+	})
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+
+	var result TranslationResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "Updated", result.Value.ValueString())
+}
+
+func TestTranslationResource_Delete(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "http://example.com/translations/tr-1", req.URL.String())
+		return mockJSONResponse(204, nil), nil
+	})
+
+	r := &TranslationResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &TranslationResourceModel{
+		ID:       types.StringValue("tr-1"),
+		Key:      types.StringValue("field_note"),
+		Language: types.StringValue("en-US"),
+		Value:    types.StringValue("Shown to editors"),
+	})
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+}
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+var (
+	_ resource.Resource                = &TranslationsResource{}
+	_ resource.ResourceWithConfigure   = &TranslationsResource{}
+	_ resource.ResourceWithImportState = &TranslationsResource{}
+	_ resource.ResourceWithModifyPlan  = &TranslationsResource{}
+)
+
+// NewTranslationsResource creates a new bulk translations resource.
+func NewTranslationsResource() resource.Resource {
+	return &TranslationsResource{}
+}
+
+// TranslationsResource defines the resource implementation.
+// It is authoritative for every language it manages: translations of those
+// languages that are not in the configuration are deleted.
+type TranslationsResource struct {
+	client *client.Client
+}
+
+// TranslationsResourceModel describes the resource data model.
+type TranslationsResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Translations types.Map    `tfsdk:"translations"`
+}
+
+func (r *TranslationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_translations"
+}
+
+func (r *TranslationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Directus Translations resource. Authoritatively manages the custom translation strings of one or more languages. " +
+			"For every language in `translations`, keys that are not configured are deleted. " +
+			"Do not combine with `directus_translation` for the same languages.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated, sorted list of the managed languages.",
+				Computed:            true,
+			},
+			"translations": schema.MapAttribute{
+				MarkdownDescription: "Map of language code (e.g., `en-US`) to a map of translation key to translated string.",
+				Required:            true,
+				ElementType:         types.MapType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (r *TranslationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan computes the ID from the planned languages, so that it is only shown as
+// changing when languages are added or removed.
+func (r *TranslationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TranslationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The languages are the map keys, which are known even if translated strings are not.
+	if plan.Translations.IsUnknown() || plan.Translations.IsNull() {
+		return
+	}
+	languages := make(map[string]map[string]string, len(plan.Translations.Elements()))
+	for language := range plan.Translations.Elements() {
+		languages[language] = nil
+	}
+	plan.ID = types.StringValue(translationsID(languages))
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *TranslationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TranslationsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := map[string]map[string]string{}
+	resp.Diagnostics.Append(plan.Translations.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.sync(ctx, desired, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Translations",
+			"Could not create translations, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(translationsID(desired))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TranslationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TranslationsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	languages := translationsLanguages(state.ID.ValueString())
+
+	records, err := r.listTranslations(ctx, languages)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Translations",
+			fmt.Sprintf("Could not read translations for %s: %s", strings.Join(languages, ", "), err.Error()),
+		)
+		return
+	}
+
+	current := make(map[string]map[string]string, len(languages))
+	for _, language := range languages {
+		current[language] = map[string]string{}
+	}
+	for _, rec := range records {
+		if keys, ok := current[rec.Language]; ok {
+			keys[rec.Key] = rec.Value
+		}
+	}
+
+	translations, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TranslationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, prior TranslationsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := map[string]map[string]string{}
+	resp.Diagnostics.Append(plan.Translations.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Languages dropped from the configuration are still cleaned up.
+	if err := r.sync(ctx, desired, translationsLanguages(prior.ID.ValueString())); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Translations",
+			"Could not update translations: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(translationsID(desired))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes every translation of the managed languages.
+func (r *TranslationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TranslationsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.sync(ctx, nil, translationsLanguages(state.ID.ValueString())); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Translations",
+			"Could not delete translations: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the translations of the given comma-separated languages.
+func (r *TranslationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	languages := translationsLanguages(req.ID)
+	if len(languages) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected a comma-separated list of languages (e.g. en-US,de-DE), got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(languages, ","))...)
+}
+
+// sync makes the translations of the desired languages, plus extraLanguages, match desired.
+// Missing keys are created, changed values updated, and all other keys of those languages deleted.
+func (r *TranslationsResource) sync(ctx context.Context, desired map[string]map[string]string, extraLanguages []string) error {
+	languageSet := map[string]bool{}
+	for language := range desired {
+		languageSet[language] = true
+	}
+	for _, language := range extraLanguages {
+		languageSet[language] = true
+	}
+	if len(languageSet) == 0 {
+		return nil
+	}
+	languages := make([]string, 0, len(languageSet))
+	for language := range languageSet {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	existing, err := r.listTranslations(ctx, languages)
+	if err != nil {
+		return fmt.Errorf("listing translations: %w", err)
+	}
+
+	toCreate, toUpdate, toDelete := diffTranslations(desired, existing)
+
+	if len(toDelete) > 0 {
+		if err := r.client.DeleteMany(ctx, "translations", toDelete); err != nil {
+			return fmt.Errorf("deleting %d translations: %w", len(toDelete), err)
+		}
+	}
+	if len(toUpdate) > 0 {
+		if err := r.client.UpdateMany(ctx, "translations", toUpdate, nil); err != nil {
+			return fmt.Errorf("updating %d translations: %w", len(toUpdate), err)
+		}
+	}
+	if len(toCreate) > 0 {
+		if err := r.client.CreateMany(ctx, "translations", toCreate, nil); err != nil {
+			return fmt.Errorf("creating %d translations: %w", len(toCreate), err)
+		}
+	}
+
+	return nil
+}
+
+// listTranslations returns all translations of the given languages.
+func (r *TranslationsResource) listTranslations(ctx context.Context, languages []string) ([]translationAPIResponse, error) {
+	if len(languages) == 0 {
+		return nil, nil
+	}
+
+	filter, err := json.Marshal(map[string]interface{}{
+		"language": map[string]interface{}{"_in": languages},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data []translationAPIResponse `json:"data"`
+	}
+
+	params := map[string]string{
+		"filter": string(filter),
+		"fields": "id,language,key,value",
+		"sort":   "language,key",
+		"limit":  "-1",
+	}
+
+	if err := r.client.ListWithParams(ctx, "translations", params, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// diffTranslations compares the desired translations with the existing records.
+// Duplicate records for the same language and key are deleted, keeping the first one.
+func diffTranslations(desired map[string]map[string]string, existing []translationAPIResponse) (toCreate, toUpdate []map[string]interface{}, toDelete []interface{}) {
+	seen := map[string]bool{}
+
+	for _, rec := range existing {
+		id := rec.Language + "\x00" + rec.Key
+		value, wanted := desired[rec.Language][rec.Key]
+		if !wanted || seen[id] {
+			toDelete = append(toDelete, rec.ID)
+			continue
+		}
+		seen[id] = true
+		if rec.Value != value {
+			toUpdate = append(toUpdate, map[string]interface{}{"id": rec.ID, "value": value})
+		}
+	}
+
+	languages := make([]string, 0, len(desired))
+	for language := range desired {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	for _, language := range languages {
+		keys := make([]string, 0, len(desired[language]))
+		for key := range desired[language] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if !seen[language+"\x00"+key] {
+				toCreate = append(toCreate, map[string]interface{}{
+					"language": language,
+					"key":      key,
+					"value":    desired[language][key],
+				})
+			}
+		}
+	}
+
+	return toCreate, toUpdate, toDelete
+}
+
+// translationsID builds the resource ID from the managed languages.
+func translationsID(translations map[string]map[string]string) string {
+	languages := make([]string, 0, len(translations))
+	for language := range translations {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return strings.Join(languages, ",")
+}
+
+// translationsLanguages parses the languages from a resource or import ID.
+func translationsLanguages(id string) []string {
+	var languages []string
+	for _, language := range strings.Split(id, ",") {
+		if language = strings.TrimSpace(language); language != "" {
+			languages = append(languages, language)
+		}
+	}
+	sort.Strings(languages)
+	return languages
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// translationsMap builds the nested map attribute of directus_translations.
+func translationsMap(t *testing.T, value map[string]map[string]string) types.Map {
+	t.Helper()
+	m, diags := types.MapValueFrom(context.Background(), types.MapType{ElemType: types.StringType}, value)
+	require.False(t, diags.HasError(), "translationsMap: %v", diags)
+	return m
+}
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestTranslationsResourceSchema(t *testing.T) {
+	r := &TranslationsResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	require.False(t, schemaResp.Diagnostics.HasError())
+	assert.True(t, schemaResp.Schema.Attributes["translations"].IsRequired())
+	assert.True(t, schemaResp.Schema.Attributes["id"].IsComputed())
+}
+
+func TestTranslationsResourceMetadata(t *testing.T) {
+	r := &TranslationsResource{}
+	metadataResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_translations", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// diffTranslations / IDs
+// ---------------------------------------------------------------------------
+
+func TestDiffTranslations(t *testing.T) {
+	desired := map[string]map[string]string{
+		"en-US": {"greeting": "Hello", "farewell": "Bye"},
+		"de-DE": {"greeting": "Hallo"},
+	}
+	existing := []translationAPIResponse{
+		{ID: "1", Language: "en-US", Key: "greeting", Value: "Hello"},
+		{ID: "2", Language: "en-US", Key: "farewell", Value: "Goodbye"},
+		{ID: "3", Language: "en-US", Key: "obsolete", Value: "Old"},
+		{ID: "4", Language: "en-US", Key: "greeting", Value: "Hello again"},
+		{ID: "5", Language: "fr-FR", Key: "greeting", Value: "Bonjour"},
+	}
+
+	toCreate, toUpdate, toDelete := diffTranslations(desired, existing)
+
+	assert.Equal(t, []map[string]interface{}{
+		{"language": "de-DE", "key": "greeting", "value": "Hallo"},
+	}, toCreate)
+	assert.Equal(t, []map[string]interface{}{
+		{"id": "2", "value": "Bye"},
+	}, toUpdate)
+	assert.Equal(t, []interface{}{"3", "4", "5"}, toDelete, "unwanted keys, duplicates and dropped languages are deleted")
+}
+
+func TestDiffTranslations_InSync(t *testing.T) {
+	toCreate, toUpdate, toDelete := diffTranslations(
+		map[string]map[string]string{"en-US": {"greeting": "Hello"}},
+		[]translationAPIResponse{{ID: "1", Language: "en-US", Key: "greeting", Value: "Hello"}},
+	)
+
+	assert.Empty(t, toCreate)
+	assert.Empty(t, toUpdate)
+	assert.Empty(t, toDelete)
+}
+
+func TestTranslationsID(t *testing.T) {
+	assert.Equal(t, "de-DE,en-US", translationsID(map[string]map[string]string{"en-US": {}, "de-DE": {}}))
+	assert.Equal(t, []string{"de-DE", "en-US"}, translationsLanguages("en-US, de-DE,"))
+	assert.Empty(t, translationsLanguages(""))
+}
+
+func TestTranslationsResource_ModifyPlan(t *testing.T) {
+	r := &TranslationsResource{}
+	schema := getResourceSchema(t, r)
+
+	prior := makeState(t, schema, &TranslationsResourceModel{
+		ID: types.StringValue("de-DE,en-US"),
+		Translations: translationsMap(t, map[string]map[string]string{
+			"en-US": {"greeting": "Hello"},
+			"de-DE": {"greeting": "Hallo"},
+		}),
+	})
+
+	tests := []struct {
+		name         string
+		translations map[string]map[string]string
+		wantID       string
+	}{
+		{"changed strings", map[string]map[string]string{"en-US": {"greeting": "Hi"}, "de-DE": {"greeting": "Hallo"}}, "de-DE,en-US"},
+		{"dropped language", map[string]map[string]string{"en-US": {"greeting": "Hello"}}, "en-US"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := makePlan(t, schema, &TranslationsResourceModel{
+				ID:           types.StringUnknown(),
+				Translations: translationsMap(t, tt.translations),
+			})
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: prior}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "ModifyPlan diagnostics: %v", resp.Diagnostics)
+
+			var result TranslationsResourceModel
+			resp.Plan.Get(context.Background(), &result)
+			assert.Equal(t, tt.wantID, result.ID.ValueString())
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func TestTranslationsResource_Create(t *testing.T) {
+	var calls []string
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+
+		switch req.Method {
+		case "GET":
+			assert.Equal(t, `{"language":{"_in":["de-DE","en-US"]}}`, req.URL.Query().Get("filter"))
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": "1", "language": "en-US", "key": "greeting", "value": "Hi"},
+					{"id": "2", "language": "en-US", "key": "stale", "value": "x"},
+				},
+			}), nil
+		case "DELETE":
+			var body []interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, []interface{}{"2"}, body)
+			return mockJSONResponse(204, nil), nil
+		case "PATCH":
+			var body []map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, []map[string]interface{}{{"id": "1", "value": "Hello"}}, body)
+			return mockJSONResponse(200, map[string]interface{}{"data": body}), nil
+		case "POST":
+			var body []map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, []map[string]interface{}{{"language": "de-DE", "key": "greeting", "value": "Hallo"}}, body)
+			return mockJSONResponse(200, map[string]interface{}{"data": body}), nil
+		}
+		return nil, nil
+	})
+
+	r := &TranslationsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	plan := makePlan(t, schema, &TranslationsResourceModel{
+		ID: types.StringUnknown(),
+		Translations: translationsMap(t, map[string]map[string]string{
+			"en-US": {"greeting": "Hello"},
+			"de-DE": {"greeting": "Hallo"},
+		}),
+	})
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []string{"GET /translations", "DELETE /translations", "PATCH /translations", "POST /translations"}, calls)
+
+	var result TranslationsResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "de-DE,en-US", result.ID.ValueString())
+}
+
+func TestTranslationsResource_UpdateDropsLanguage(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case "GET":
+			assert.Equal(t, `{"language":{"_in":["de-DE","en-US"]}}`, req.URL.Query().Get("filter"),
+				"languages removed from the configuration must still be listed")
+			return mockJSONResponse(200, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": "1", "language": "en-US", "key": "greeting", "value": "Hello"},
+					{"id": "2", "language": "de-DE", "key": "greeting", "value": "Hallo"},
+				},
+			}), nil
+		case "DELETE":
+			var body []interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &body)
+			assert.Equal(t, []interface{}{"2"}, body)
+			return mockJSONResponse(204, nil), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &TranslationsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	prior := makeState(t, schema, &TranslationsResourceModel{
+		ID: types.StringValue("de-DE,en-US"),
+		Translations: translationsMap(t, map[string]map[string]string{
+			"en-US": {"greeting": "Hello"},
+			"de-DE": {"greeting": "Hallo"},
+		}),
+	})
+	plan := makePlan(t, schema, &TranslationsResourceModel{
+		ID:           types.StringUnknown(),
+		Translations: translationsMap(t, map[string]map[string]string{"en-US": {"greeting": "Hello"}}),
+	})
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schema}}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: plan, State: prior}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update diagnostics: %v", resp.Diagnostics)
+
+	var result TranslationsResourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "en-US", result.ID.ValueString())
+}
+
+func TestTranslationsResource_Read(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", req.Method)
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "1", "language": "en-US", "key": "greeting", "value": "Hello"},
+				{"id": "3", "language": "en-US", "key": "added_in_app", "value": "New"},
+			},
+		}), nil
+	})
+
+	r := &TranslationsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &TranslationsResourceModel{
+		ID: types.StringValue("de-DE,en-US"),
+		Translations: translationsMap(t, map[string]map[string]string{
+			"en-US": {"greeting": "Hello"},
+			"de-DE": {"greeting": "Hallo"},
+		}),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result TranslationsResourceModel
+	resp.State.Get(context.Background(), &result)
+
+	got := map[string]map[string]string{}
+	result.Translations.ElementsAs(context.Background(), &got, false)
+	assert.Equal(t, map[string]map[string]string{
+		"en-US": {"greeting": "Hello", "added_in_app": "New"},
+		"de-DE": {},
+	}, got)
+}
+
+func TestTranslationsResource_Delete(t *testing.T) {
+	var deleted bool
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method == "DELETE" {
+			deleted = true
+			return mockJSONResponse(204, nil), nil
+		}
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": []map[string]interface{}{{"id": "1", "language": "en-US", "key": "greeting", "value": "Hello"}},
+		}), nil
+	})
+
+	r := &TranslationsResource{client: mockClient}
+	schema := getResourceSchema(t, r)
+
+	state := makeState(t, schema, &TranslationsResourceModel{
+		ID:           types.StringValue("en-US"),
+		Translations: translationsMap(t, map[string]map[string]string{"en-US": {"greeting": "Hello"}}),
+	})
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Delete diagnostics: %v", resp.Diagnostics)
+	assert.True(t, deleted)
+}
+
+func TestTranslationsResource_ImportState(t *testing.T) {
+	r := &TranslationsResource{}
+	schema := getResourceSchema(t, r)
+
+	resp := &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "en-US,de-DE"}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "ImportState diagnostics: %v", resp.Diagnostics)
+
+	var id types.String
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	assert.Equal(t, "de-DE,en-US", id.ValueString())
+
+	resp = &fwresource.ImportStateResponse{State: makeNullState(t, schema)}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: " , "}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
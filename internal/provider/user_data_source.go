@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+// userReadFields lists the user fields requested by the user data sources. Fields are
+// always listed explicitly so that secrets such as token, tfa_secret and password are
+// never read into state.
+const userReadFields = "id,email,first_name,last_name,title,description,location,tags,avatar,language," +
+	"status,role,provider,external_identifier,last_access,policies.policy"
+
+var (
+	_ datasource.DataSource                   = &UserDataSource{}
+	_ datasource.DataSourceWithConfigure      = &UserDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &UserDataSource{}
+)
+
+// NewUserDataSource creates a new user data source.
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *client.Client
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Email              types.String `tfsdk:"email"`
+	FirstName          types.String `tfsdk:"first_name"`
+	LastName           types.String `tfsdk:"last_name"`
+	Title              types.String `tfsdk:"title"`
+	Description        types.String `tfsdk:"description"`
+	Location           types.String `tfsdk:"location"`
+	Tags               types.List   `tfsdk:"tags"`
+	Avatar             types.String `tfsdk:"avatar"`
+	Language           types.String `tfsdk:"language"`
+	Status             types.String `tfsdk:"status"`
+	Role               types.String `tfsdk:"role"`
+	Provider           types.String `tfsdk:"provider"`
+	ExternalIdentifier types.String `tfsdk:"external_identifier"`
+	LastAccess         types.String `tfsdk:"last_access"`
+	PolicyIDs          types.Set    `tfsdk:"policy_ids"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Directus user by `id` or `email`, such as a user created by SSO login. " +
+			"Secrets such as the static token and the two-factor secret are never read.",
+
+		Attributes: userDataSourceAttributes(),
+	}
+
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The UUID of the user. Exactly one of `id` or `email` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["email"] = schema.StringAttribute{
+		MarkdownDescription: "The email address of the user.",
+		Optional:            true,
+		Computed:            true,
+	}
+}
+
+// userDataSourceAttributes returns the computed attributes describing a user, shared by
+// the directus_user and directus_users data sources.
+func userDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The UUID of the user.",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "The email address of the user.",
+			Computed:            true,
+		},
+		"first_name": schema.StringAttribute{
+			MarkdownDescription: "The first name of the user.",
+			Computed:            true,
+		},
+		"last_name": schema.StringAttribute{
+			MarkdownDescription: "The last name of the user.",
+			Computed:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the user.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the user.",
+			Computed:            true,
+		},
+		"location": schema.StringAttribute{
+			MarkdownDescription: "The location of the user.",
+			Computed:            true,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "Tags of the user.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"avatar": schema.StringAttribute{
+			MarkdownDescription: "The UUID of the avatar file.",
+			Computed:            true,
+		},
+		"language": schema.StringAttribute{
+			MarkdownDescription: "The language of the Data Studio for the user.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the user: `draft`, `invited`, `unverified`, `active`, `suspended`, or `archived`.",
+			Computed:            true,
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "The UUID of the role of the user.",
+			Computed:            true,
+		},
+		"provider": schema.StringAttribute{
+			MarkdownDescription: "The authentication provider of the user, `default` for local users.",
+			Computed:            true,
+		},
+		"external_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the user at the external authentication provider.",
+			Computed:            true,
+		},
+		"last_access": schema.StringAttribute{
+			MarkdownDescription: "When the user last accessed the API.",
+			Computed:            true,
+		},
+		"policy_ids": schema.SetAttribute{
+			MarkdownDescription: "UUIDs of the policies attached to the user directly. Policies of the role are not included.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// ValidateConfig checks that the user is looked up by exactly one of id or email.
+func (d *UserDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Email.IsUnknown() {
+		return
+	}
+	if data.ID.IsNull() == data.Email.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid User Lookup",
+			"Exactly one of id or email must be set.",
+		)
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user userAPIResponse
+	if !data.ID.IsNull() {
+		var result struct {
+			Data userAPIResponse `json:"data"`
+		}
+		if err := d.client.GetWithParams(ctx, "users", data.ID.ValueString(), map[string]string{"fields": userReadFields}, &result); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading User",
+				fmt.Sprintf("Could not read user %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		user = result.Data
+	} else {
+		var result struct {
+			Data []userAPIResponse `json:"data"`
+		}
+		params := map[string]string{
+			"filter[email][_eq]": data.Email.ValueString(),
+			"fields":             userReadFields,
+			"limit":              "1",
+		}
+		if err := d.client.ListWithParams(ctx, "users", params, &result); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading User",
+				fmt.Sprintf("Could not look up user %q: %s", data.Email.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		// Email addresses are unique in Directus, so at most one user matches.
+		if len(result.Data) == 0 {
+			resp.Diagnostics.AddError(
+				"User Not Found",
+				fmt.Sprintf("No user has the email address %q.", data.Email.ValueString()),
+			)
+			return
+		}
+		user = result.Data[0]
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, user.toDataSourceModel())...)
+}
+
+// userAPIResponse is a user as returned for userReadFields.
+type userAPIResponse struct {
+	ID                 string   `json:"id"`
+	Email              string   `json:"email,omitempty"`
+	FirstName          string   `json:"first_name,omitempty"`
+	LastName           string   `json:"last_name,omitempty"`
+	Title              string   `json:"title,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	Location           string   `json:"location,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+	Avatar             string   `json:"avatar,omitempty"`
+	Language           string   `json:"language,omitempty"`
+	Status             string   `json:"status,omitempty"`
+	Role               string   `json:"role,omitempty"`
+	Provider           string   `json:"provider,omitempty"`
+	ExternalIdentifier string   `json:"external_identifier,omitempty"`
+	LastAccess         string   `json:"last_access,omitempty"`
+	Policies           []struct {
+		Policy string `json:"policy"`
+	} `json:"policies,omitempty"`
+}
+
+// toDataSourceModel converts userAPIResponse to UserDataSourceModel.
+func (u *userAPIResponse) toDataSourceModel() *UserDataSourceModel {
+	var policyIDs []string
+	for _, access := range u.Policies {
+		if access.Policy != "" {
+			policyIDs = append(policyIDs, access.Policy)
+		}
+	}
+
+	return &UserDataSourceModel{
+		ID:                 types.StringValue(u.ID),
+		Email:              stringOrNull(u.Email),
+		FirstName:          stringOrNull(u.FirstName),
+		LastName:           stringOrNull(u.LastName),
+		Title:              stringOrNull(u.Title),
+		Description:        stringOrNull(u.Description),
+		Location:           stringOrNull(u.Location),
+		Tags:               stringListOrNull(u.Tags),
+		Avatar:             stringOrNull(u.Avatar),
+		Language:           stringOrNull(u.Language),
+		Status:             stringOrNull(u.Status),
+		Role:               stringOrNull(u.Role),
+		Provider:           stringOrNull(u.Provider),
+		ExternalIdentifier: stringOrNull(u.ExternalIdentifier),
+		LastAccess:         stringOrNull(u.LastAccess),
+		PolicyIDs:          stringSetOrNull(policyIDs),
+	}
+}
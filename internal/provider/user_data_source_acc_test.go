@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource_basic(t *testing.T) {
+	// The user only exists once the pre-check has run, so its ID is passed as a variable.
+	variables := config.Variables{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			variables["user_id"] = config.StringVariable(testAccCreateUser(t, "acctest-user-lookup@example.com"))
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigVariables: variables,
+				Config: testAccProviderConfig() + `
+variable "user_id" {
+  type = string
+}
+
+data "directus_user" "by_email" {
+  email = "acctest-user-lookup@example.com"
+}
+
+data "directus_user" "by_id" {
+  id = var.user_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.directus_user.by_email", "id", "data.directus_user.by_id", "id"),
+					resource.TestCheckResourceAttr("data.directus_user.by_id", "email", "acctest-user-lookup@example.com"),
+					resource.TestCheckResourceAttr("data.directus_user.by_id", "status", "active"),
+					resource.TestCheckNoResourceAttr("data.directus_user.by_id", "token"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newUserLookupModel returns a data source config model that looks a user up by id or email.
+func newUserLookupModel(id, email string) *UserDataSourceModel {
+	return &UserDataSourceModel{
+		ID:        stringOrNull(id),
+		Email:     stringOrNull(email),
+		Tags:      types.ListNull(types.StringType),
+		PolicyIDs: types.SetNull(types.StringType),
+	}
+}
+
+// readUserDataSource runs Read for the given lookup and returns the response.
+func readUserDataSource(t *testing.T, d *UserDataSource, model *UserDataSourceModel) *fwdatasource.ReadResponse {
+	t.Helper()
+	schema := getDataSourceSchema(t, d)
+
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, model)}, resp)
+	return resp
+}
+
+func TestUserDataSource_Metadata(t *testing.T) {
+	d := &UserDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_user", resp.TypeName)
+}
+
+func TestUserDataSource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		email     string
+		expectErr bool
+	}{
+		{"by id", "user-1", "", false},
+		{"by email", "", "jane@example.com", false},
+		{"neither", "", "", true},
+		{"both", "user-1", "jane@example.com", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &UserDataSource{}
+			schema := getDataSourceSchema(t, d)
+
+			resp := &fwdatasource.ValidateConfigResponse{}
+			d.ValidateConfig(context.Background(), fwdatasource.ValidateConfigRequest{
+				Config: makeDataSourceConfig(t, schema, newUserLookupModel(tt.id, tt.email)),
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestUserReadFields_ExcludeSecrets(t *testing.T) {
+	for _, field := range strings.Split(userReadFields, ",") {
+		assert.NotContains(t, []string{"*", "token", "tfa_secret", "password"}, field)
+	}
+}
+
+func TestUserDataSource_Read_ByID(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/users/user-1", req.URL.Path)
+		assert.Equal(t, userReadFields, req.URL.Query().Get("fields"))
+		return mockJSONResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":                  "user-1",
+				"email":               "jane@example.com",
+				"first_name":          "Jane",
+				"status":              "active",
+				"role":                "role-1",
+				"provider":            "keycloak",
+				"external_identifier": "jane",
+				"tags":                []string{"sso"},
+				"policies":            []interface{}{map[string]interface{}{"policy": "policy-2"}, map[string]interface{}{"policy": "policy-1"}},
+			},
+		}), nil
+	})
+
+	resp := readUserDataSource(t, &UserDataSource{client: mockClient}, newUserLookupModel("user-1", ""))
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result UserDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Equal(t, "jane@example.com", result.Email.ValueString())
+	assert.Equal(t, "keycloak", result.Provider.ValueString())
+	assert.Equal(t, "jane", result.ExternalIdentifier.ValueString())
+	assert.Equal(t, []string{"sso"}, stringListValues(result.Tags))
+	assert.ElementsMatch(t, []string{"policy-1", "policy-2"}, stringSetValues(result.PolicyIDs))
+	assert.True(t, result.LastName.IsNull())
+}
+
+func TestUserDataSource_Read_ByEmail(t *testing.T) {
+	tests := []struct {
+		name      string
+		users     []interface{}
+		expectErr string
+	}{
+		{"match", []interface{}{map[string]interface{}{"id": "user-1", "email": "jane@example.com"}}, ""},
+		{"no match", []interface{}{}, "User Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/users", req.URL.Path)
+				assert.Equal(t, "jane@example.com", req.URL.Query().Get("filter[email][_eq]"))
+				assert.Equal(t, userReadFields, req.URL.Query().Get("fields"))
+				return mockJSONResponse(200, map[string]interface{}{"data": tt.users}), nil
+			})
+
+			resp := readUserDataSource(t, &UserDataSource{client: mockClient}, newUserLookupModel("", "jane@example.com"))
+
+			if tt.expectErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectErr, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+			var result UserDataSourceModel
+			resp.State.Get(context.Background(), &result)
+			assert.Equal(t, "user-1", result.ID.ValueString())
+			assert.True(t, result.PolicyIDs.IsNull())
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+const (
+	// defaultUserTokenLength is the token length used when length is not configured.
+	defaultUserTokenLength = 32
+	// minUserTokenLength keeps generated tokens long enough to be used as credentials.
+	minUserTokenLength = 16
+	// maxUserTokenLength is the maximum length accepted by /utils/random/string.
+	maxUserTokenLength = 500
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &UserTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &UserTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &UserTokenEphemeralResource{}
+)
+
+// NewUserTokenEphemeralResource creates a new user token ephemeral resource.
+func NewUserTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &UserTokenEphemeralResource{}
+}
+
+// UserTokenEphemeralResource defines the ephemeral resource implementation.
+type UserTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// UserTokenEphemeralResourceModel describes the ephemeral resource data model.
+type UserTokenEphemeralResourceModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Length types.Int64  `tfsdk:"length"`
+	Token  types.String `tfsdk:"token"`
+}
+
+func (r *UserTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (r *UserTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a static token for a Directus user and assigns it to that user. " +
+			"The token is only available as an ephemeral value and is never stored in plan or state. " +
+			"A new token is generated, replacing the user's previous token, every time Terraform opens this ephemeral resource. " +
+			"Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the user the token is assigned to.",
+				Required:            true,
+			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The length of the generated token, between %d and %d. Defaults to `%d`.",
+					minUserTokenLength, maxUserTokenLength, defaultUserTokenLength),
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The generated static token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the token length is within the supported range.
+func (r *UserTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data UserTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Length.IsNull() || data.Length.IsUnknown() {
+		return
+	}
+	if length := data.Length.ValueInt64(); length < minUserTokenLength || length > maxUserTokenLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length"),
+			"Invalid Token Length",
+			fmt.Sprintf("length must be between %d and %d, got: %d", minUserTokenLength, maxUserTokenLength, length),
+		)
+	}
+}
+
+func (r *UserTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Open generates a random string and assigns it as the user's static token. The PATCH
+// response is not decoded, so the token only leaves the provider through the result.
+func (r *UserTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data UserTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(defaultUserTokenLength)
+	if !data.Length.IsNull() && !data.Length.IsUnknown() {
+		length = data.Length.ValueInt64()
+	}
+
+	token, err := r.client.RandomString(ctx, int(length))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating User Token",
+			"Could not generate a random token: "+err.Error(),
+		)
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	if err := r.client.Update(ctx, "users", userID, map[string]interface{}{"token": token}, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Assigning User Token",
+			"Could not assign token to user ID "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserToken_basic(t *testing.T) {
+	// The user only exists once the pre-check has run, so its ID is passed as a variable.
+	variables := config.Variables{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			variables["user_id"] = config.StringVariable(testAccCreateUser(t, "acctest-user-token@example.com"))
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		// The echo provider copies the ephemeral token into state so that it can be checked.
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"directus": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":     echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigVariables: variables,
+				Config: testAccProviderConfig() + `
+variable "user_id" {
+  type = string
+}
+
+ephemeral "directus_user_token" "test" {
+  user_id = var.user_id
+  length  = 40
+}
+
+provider "echo" {
+  data = ephemeral.directus_user_token.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("length"), knownvalue.Int64Exact(40)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.StringRegexp(regexp.MustCompile(`^.{40}$`))),
+				},
+			},
+		},
+	})
+}
+
+// testAccCreateUser creates a throwaway Directus user through the API and removes it
+// when the test finishes. Tokens are never rotated for the user running the tests.
+func testAccCreateUser(t *testing.T, email string) string {
+	t.Helper()
+
+	endpoint := os.Getenv("DIRECTUS_ENDPOINT")
+	token := os.Getenv("DIRECTUS_TOKEN")
+
+	body, _ := json.Marshal(map[string]interface{}{"email": email, "status": "active"})
+	req, err := http.NewRequest("POST", endpoint+"/users", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create user request: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to create test user: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Directus returned HTTP %d when creating test user", resp.StatusCode)
+	}
+
+	var created struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("Failed to decode created test user: %s", err)
+	}
+
+	t.Cleanup(func() {
+		req, err := http.NewRequest("DELETE", endpoint+"/users/"+created.Data.ID, nil)
+		if err != nil {
+			return
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			resp.Body.Close()
+		}
+	})
+
+	return created.Data.ID
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// getEphemeralResourceSchema extracts the schema from an ephemeral resource.
+func getEphemeralResourceSchema(t *testing.T, r ephemeral.EphemeralResource) eschema.Schema {
+	t.Helper()
+	resp := &ephemeral.SchemaResponse{}
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+	return resp.Schema
+}
+
+// makeEphemeralConfig creates a tfsdk.Config for an ephemeral resource populated with the given model.
+func makeEphemeralConfig(t *testing.T, schema eschema.Schema, model interface{}) tfsdk.Config {
+	t.Helper()
+	result := tfsdk.EphemeralResultData{Schema: schema}
+	diags := result.Set(context.Background(), model)
+	require.False(t, diags.HasError(), "makeEphemeralConfig: %v", diags)
+	return tfsdk.Config{Schema: schema, Raw: result.Raw}
+}
+
+func newUserTokenModel() *UserTokenEphemeralResourceModel {
+	return &UserTokenEphemeralResourceModel{
+		UserID: types.StringValue("user-1"),
+		Length: types.Int64Null(),
+		Token:  types.StringNull(),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Schema & Metadata
+// ---------------------------------------------------------------------------
+
+func TestUserTokenEphemeralResourceSchema(t *testing.T) {
+	schema := getEphemeralResourceSchema(t, &UserTokenEphemeralResource{})
+
+	for _, attr := range []string{"user_id", "length", "token"} {
+		assert.NotNil(t, schema.Attributes[attr], "%s attribute should exist", attr)
+	}
+	assert.True(t, schema.Attributes["user_id"].IsRequired())
+	assert.True(t, schema.Attributes["token"].IsComputed())
+	assert.True(t, schema.Attributes["token"].IsSensitive())
+}
+
+func TestUserTokenEphemeralResourceMetadata(t *testing.T) {
+	r := &UserTokenEphemeralResource{}
+	metadataResp := &ephemeral.MetadataResponse{}
+	r.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "directus"}, metadataResp)
+
+	assert.Equal(t, "directus_user_token", metadataResp.TypeName)
+}
+
+// ---------------------------------------------------------------------------
+// ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestUserTokenEphemeralResource_ValidateConfig(t *testing.T) {
+	r := &UserTokenEphemeralResource{}
+	schema := getEphemeralResourceSchema(t, r)
+
+	tests := []struct {
+		name      string
+		length    types.Int64
+		expectErr bool
+	}{
+		{"default length", types.Int64Null(), false},
+		{"minimum length", types.Int64Value(16), false},
+		{"maximum length", types.Int64Value(500), false},
+		{"too short", types.Int64Value(8), true},
+		{"too long", types.Int64Value(501), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newUserTokenModel()
+			model.Length = tt.length
+
+			resp := &ephemeral.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), ephemeral.ValidateConfigRequest{
+				Config: makeEphemeralConfig(t, schema, model),
+			}, resp)
+
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Configure
+// ---------------------------------------------------------------------------
+
+func TestUserTokenEphemeralResource_Configure_WrongType(t *testing.T) {
+	r := &UserTokenEphemeralResource{}
+	resp := &ephemeral.ConfigureResponse{}
+
+	r.Configure(context.Background(), ephemeral.ConfigureRequest{
+		ProviderData: "not-a-client",
+	}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+// ---------------------------------------------------------------------------
+// Open
+// ---------------------------------------------------------------------------
+
+func TestUserTokenEphemeralResource_Open(t *testing.T) {
+	var patched map[string]interface{}
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case "GET":
+			assert.Equal(t, "http://example.com/utils/random/string?length=48", req.URL.String())
+			return mockJSONResponse(200, map[string]interface{}{"data": "generated-token"}), nil
+		case "PATCH":
+			assert.Equal(t, "http://example.com/users/user-1", req.URL.String())
+			bodyBytes, _ := io.ReadAll(req.Body)
+			require.NoError(t, json.Unmarshal(bodyBytes, &patched))
+			return mockJSONResponse(200, map[string]interface{}{"data": map[string]interface{}{"id": "user-1"}}), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	r := &UserTokenEphemeralResource{client: mockClient}
+	schema := getEphemeralResourceSchema(t, r)
+
+	model := newUserTokenModel()
+	model.Length = types.Int64Value(48)
+
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schema}}
+	r.Open(context.Background(), ephemeral.OpenRequest{Config: makeEphemeralConfig(t, schema, model)}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, map[string]interface{}{"token": "generated-token"}, patched)
+
+	var result UserTokenEphemeralResourceModel
+	resp.Diagnostics.Append(resp.Result.Get(context.Background(), &result)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "user-1", result.UserID.ValueString())
+	assert.Equal(t, int64(48), result.Length.ValueInt64())
+	assert.Equal(t, "generated-token", result.Token.ValueString())
+}
+
+func TestUserTokenEphemeralResource_Open_DefaultLength(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method == "GET" {
+			assert.Equal(t, "32", req.URL.Query().Get("length"))
+			return mockJSONResponse(200, map[string]interface{}{"data": "generated-token"}), nil
+		}
+		return mockJSONResponse(200, map[string]interface{}{"data": map[string]interface{}{"id": "user-1"}}), nil
+	})
+
+	r := &UserTokenEphemeralResource{client: mockClient}
+	schema := getEphemeralResourceSchema(t, r)
+
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schema}}
+	r.Open(context.Background(), ephemeral.OpenRequest{Config: makeEphemeralConfig(t, schema, newUserTokenModel())}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	var result UserTokenEphemeralResourceModel
+	resp.Diagnostics.Append(resp.Result.Get(context.Background(), &result)...)
+	assert.True(t, result.Length.IsNull())
+	assert.Equal(t, "generated-token", result.Token.ValueString())
+}
+
+func TestUserTokenEphemeralResource_Open_AssignError(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method == "GET" {
+			return mockJSONResponse(200, map[string]interface{}{"data": "generated-token"}), nil
+		}
+		return mockErrorResponse(403, "You don't have permission to access this."), nil
+	})
+
+	r := &UserTokenEphemeralResource{client: mockClient}
+	schema := getEphemeralResourceSchema(t, r)
+
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schema}}
+	r.Open(context.Background(), ephemeral.OpenRequest{Config: makeEphemeralConfig(t, schema, newUserTokenModel())}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Error Assigning User Token")
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylindc/terraform-provider-directus/internal/client"
+)
+
+// usersPageSize is the number of users requested per page. Instances may cap the page
+// size with QUERY_LIMIT_MAX, so paging continues until an empty page is returned rather
+// than until a short one.
+const usersPageSize = 100
+
+var (
+	_ datasource.DataSource                   = &UsersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &UsersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &UsersDataSource{}
+)
+
+// NewUsersDataSource creates a new users data source.
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *client.Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Filter             types.String          `tfsdk:"filter"`
+	Sort               types.List            `tfsdk:"sort"`
+	Limit              types.Int64           `tfsdk:"limit"`
+	Role               types.String          `tfsdk:"role"`
+	Status             types.String          `tfsdk:"status"`
+	ExternalIdentifier types.String          `tfsdk:"external_identifier"`
+	Users              []UserDataSourceModel `tfsdk:"users"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Directus users, optionally filtered, sorted and limited on the server. " +
+			"Users are read page by page, so instances with many users are listed completely. " +
+			"Secrets such as static tokens and two-factor secrets are never read.",
+
+		Attributes: listQueryAttributes("users"),
+	}
+
+	resp.Schema.Attributes["role"] = schema.StringAttribute{
+		MarkdownDescription: "Only list users with the role of this UUID. Combined with `filter` and the other arguments.",
+		Optional:            true,
+	}
+	resp.Schema.Attributes["status"] = schema.StringAttribute{
+		MarkdownDescription: "Only list users with this status, e.g. `active`. Combined with `filter` and the other arguments.",
+		Optional:            true,
+	}
+	resp.Schema.Attributes["external_identifier"] = schema.StringAttribute{
+		MarkdownDescription: "Only list users with this identifier at the external authentication provider. " +
+			"Combined with `filter` and the other arguments.",
+		Optional: true,
+	}
+	resp.Schema.Attributes["users"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching users.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: userDataSourceAttributes(),
+		},
+	}
+}
+
+// ValidateConfig checks the filter expression, limit and status.
+func (d *UsersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateJSONObject(data.Filter); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+	}
+	if err := validatePositiveInt64(data.Limit); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid Limit", err.Error())
+	}
+	if err := validateStringOneOf(data.Status, "draft", "invited", "unverified", "active", "suspended", "archived"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("status"), "Invalid Status", err.Error())
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := usersFilter(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Filter", err.Error())
+		return
+	}
+
+	// Pages are only stable with a deterministic order, so id is always the last sort key.
+	sortFields := stringListValues(data.Sort)
+	if !slices.Contains(sortFields, "id") && !slices.Contains(sortFields, "-id") {
+		sortFields = append(sortFields, "id")
+	}
+
+	data.Users = []UserDataSourceModel{}
+	for offset := 0; ; {
+		pageSize := usersPageSize
+		if !data.Limit.IsNull() {
+			remaining := int(data.Limit.ValueInt64()) - len(data.Users)
+			if remaining <= 0 {
+				break
+			}
+			pageSize = min(pageSize, remaining)
+		}
+
+		var result struct {
+			Data []userAPIResponse `json:"data"`
+		}
+		params := map[string]string{
+			"fields": userReadFields,
+			"sort":   strings.Join(sortFields, ","),
+			"limit":  strconv.Itoa(pageSize),
+			"offset": strconv.Itoa(offset),
+		}
+		if filter != "" {
+			params["filter"] = filter
+		}
+		if err := d.client.ListWithParams(ctx, "users", params, &result); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Users",
+				fmt.Sprintf("Could not list users: %s", err.Error()),
+			)
+			return
+		}
+		if len(result.Data) == 0 {
+			break
+		}
+
+		for _, user := range result.Data {
+			data.Users = append(data.Users, *user.toDataSourceModel())
+		}
+		offset += len(result.Data)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// usersFilter combines the filter expression with the role, status and
+// external_identifier arguments into a single filter. It returns "" if there is none.
+func usersFilter(data UsersDataSourceModel) (string, error) {
+	var conditions []interface{}
+	if !data.Filter.IsNull() {
+		var filter map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Filter.ValueString()), &filter); err != nil {
+			return "", err
+		}
+		conditions = append(conditions, filter)
+	}
+	for _, arg := range []struct {
+		field string
+		value types.String
+	}{
+		{"role", data.Role},
+		{"status", data.Status},
+		{"external_identifier", data.ExternalIdentifier},
+	} {
+		if !arg.value.IsNull() {
+			conditions = append(conditions, map[string]interface{}{
+				arg.field: map[string]interface{}{"_eq": arg.value.ValueString()},
+			})
+		}
+	}
+
+	var combined interface{}
+	switch len(conditions) {
+	case 0:
+		return "", nil
+	case 1:
+		combined = conditions[0]
+	default:
+		combined = map[string]interface{}{"_and": conditions}
+	}
+
+	encoded, err := json.Marshal(combined)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
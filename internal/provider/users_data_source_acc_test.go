@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateUser(t, "acctest-users-list-a@example.com")
+			testAccCreateUser(t, "acctest-users-list-b@example.com")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "directus_users" "test" {
+  filter = jsonencode({ email = { _starts_with = "acctest-users-list-" } })
+  status = "active"
+  sort   = ["-email"]
+}
+
+data "directus_users" "limited" {
+  filter = jsonencode({ email = { _starts_with = "acctest-users-list-" } })
+  sort   = ["email"]
+  limit  = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.directus_users.test", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.directus_users.test", "users.0.email", "acctest-users-list-b@example.com"),
+					resource.TestCheckResourceAttr("data.directus_users.limited", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.directus_users.limited", "users.0.email", "acctest-users-list-a@example.com"),
+				),
+			},
+		},
+	})
+}
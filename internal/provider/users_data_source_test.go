@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newUsersQueryModel returns a data source config model without any query arguments.
+func newUsersQueryModel() *UsersDataSourceModel {
+	return &UsersDataSourceModel{
+		Filter:             types.StringNull(),
+		Sort:               types.ListNull(types.StringType),
+		Limit:              types.Int64Null(),
+		Role:               types.StringNull(),
+		Status:             types.StringNull(),
+		ExternalIdentifier: types.StringNull(),
+	}
+}
+
+// readUsersDataSource runs Read against total users served in pages of at most pageCap
+// users, and returns the response and the offsets that were requested.
+func readUsersDataSource(t *testing.T, model *UsersDataSourceModel, total, pageCap int) (*fwdatasource.ReadResponse, []int) {
+	t.Helper()
+	var offsets []int
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/users", req.URL.Path)
+		assert.Equal(t, userReadFields, req.URL.Query().Get("fields"))
+
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+		offsets = append(offsets, offset)
+
+		users := []interface{}{}
+		for i := offset; i < total && i < offset+min(limit, pageCap); i++ {
+			users = append(users, map[string]interface{}{"id": fmt.Sprintf("user-%04d", i)})
+		}
+		return mockJSONResponse(200, map[string]interface{}{"data": users}), nil
+	})
+
+	d := &UsersDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, model)}, resp)
+	return resp, offsets
+}
+
+func TestUsersDataSource_Metadata(t *testing.T) {
+	d := &UsersDataSource{}
+	resp := &fwdatasource.MetadataResponse{}
+	d.Metadata(context.Background(), fwdatasource.MetadataRequest{ProviderTypeName: "directus"}, resp)
+	assert.Equal(t, "directus_users", resp.TypeName)
+}
+
+func TestUsersDataSource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(*UsersDataSourceModel)
+		expectErr bool
+	}{
+		{"no query", func(m *UsersDataSourceModel) {}, false},
+		{"status", func(m *UsersDataSourceModel) { m.Status = types.StringValue("active") }, false},
+		{"invalid status", func(m *UsersDataSourceModel) { m.Status = types.StringValue("enabled") }, true},
+		{"invalid filter", func(m *UsersDataSourceModel) { m.Filter = types.StringValue(`"x"`) }, true},
+		{"invalid limit", func(m *UsersDataSourceModel) { m.Limit = types.Int64Value(0) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &UsersDataSource{}
+			schema := getDataSourceSchema(t, d)
+
+			model := newUsersQueryModel()
+			tt.modify(model)
+			resp := &fwdatasource.ValidateConfigResponse{}
+			d.ValidateConfig(context.Background(), fwdatasource.ValidateConfigRequest{
+				Config: makeDataSourceConfig(t, schema, model),
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestUsersFilter(t *testing.T) {
+	model := newUsersQueryModel()
+	filter, err := usersFilter(*model)
+	require.NoError(t, err)
+	assert.Empty(t, filter)
+
+	model.Status = types.StringValue("active")
+	filter, err = usersFilter(*model)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status": {"_eq": "active"}}`, filter)
+
+	model.Filter = types.StringValue(`{"provider": {"_eq": "keycloak"}}`)
+	model.Role = types.StringValue("role-1")
+	model.ExternalIdentifier = types.StringValue("jane")
+	filter, err = usersFilter(*model)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"_and": [
+		{"provider": {"_eq": "keycloak"}},
+		{"role": {"_eq": "role-1"}},
+		{"status": {"_eq": "active"}},
+		{"external_identifier": {"_eq": "jane"}}
+	]}`, filter)
+}
+
+func TestUsersDataSource_Read_Paginates(t *testing.T) {
+	resp, offsets := readUsersDataSource(t, newUsersQueryModel(), 250, usersPageSize)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []int{0, 100, 200, 250}, offsets)
+
+	var result UsersDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	require.Len(t, result.Users, 250)
+	assert.Equal(t, "user-0249", result.Users[249].ID.ValueString())
+}
+
+func TestUsersDataSource_Read_ServerPageCap(t *testing.T) {
+	// QUERY_LIMIT_MAX below the page size must not end the listing early.
+	resp, offsets := readUsersDataSource(t, newUsersQueryModel(), 120, 50)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []int{0, 50, 100, 120}, offsets)
+
+	var result UsersDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Len(t, result.Users, 120)
+}
+
+func TestUsersDataSource_Read_Limit(t *testing.T) {
+	model := newUsersQueryModel()
+	model.Limit = types.Int64Value(150)
+
+	resp, offsets := readUsersDataSource(t, model, 1000, usersPageSize)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []int{0, 100}, offsets)
+
+	var result UsersDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.Len(t, result.Users, 150)
+}
+
+func TestUsersDataSource_Read_Query(t *testing.T) {
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		assert.JSONEq(t, `{"external_identifier": {"_eq": "jane"}}`, query.Get("filter"))
+		assert.Equal(t, "-last_access,id", query.Get("sort"))
+		return mockJSONResponse(200, map[string]interface{}{"data": []interface{}{}}), nil
+	})
+
+	model := newUsersQueryModel()
+	model.ExternalIdentifier = types.StringValue("jane")
+	model.Sort = stringListOrNull([]string{"-last_access"})
+
+	d := &UsersDataSource{client: mockClient}
+	schema := getDataSourceSchema(t, d)
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), fwdatasource.ReadRequest{Config: makeDataSourceConfig(t, schema, model)}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read diagnostics: %v", resp.Diagnostics)
+
+	var result UsersDataSourceModel
+	resp.State.Get(context.Background(), &result)
+	assert.NotNil(t, result.Users)
+	assert.Empty(t, result.Users)
+}